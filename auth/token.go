@@ -135,7 +135,9 @@ func (m *Manager) RefreshToken(ctx context.Context) (string, error) {
 }
 
 // ForceUpdate forces a token refresh (rarely needed).
+// The current token is invalidated first so a fresh one is always fetched.
 func (m *Manager) ForceUpdate(ctx context.Context) error {
+	m.invalidate()
 	return m.update(ctx)
 }
 
@@ -151,6 +153,12 @@ func (m *Manager) isValid() bool {
 	return time.Since(m.tokenTS) < m.maxUpdatePeriod
 }
 
+func (m *Manager) invalidate() {
+	m.mu.Lock()
+	m.tokenTS = time.Time{}
+	m.mu.Unlock()
+}
+
 type updateResult struct{} // Empty struct as we only care about success/error
 
 func (m *Manager) update(ctx context.Context) error {
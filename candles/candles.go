@@ -0,0 +1,390 @@
+// Package candles aggregates live NEPSE quotes and trades into OHLCV bars.
+//
+// NEPSE publishes no intraday candles for securities, only polled snapshots. A
+// Builder turns those snapshots (or floor sheet trades) into bars of one or more
+// intervals per symbol, sends each bar on a channel once its interval is over,
+// and exposes the bar still in progress:
+//
+//	builder := candles.NewBuilder(256, candles.OneMinute, candles.FiveMinutes)
+//	go builder.Run(ctx, client, 5*time.Second)
+//	for bar := range builder.Bars() {
+//		chart.Append(bar.Symbol, bar.Interval, bar.Candle)
+//	}
+package candles
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/voidarchive/nepseauth/nepse"
+)
+
+// Common bar intervals
+const (
+	OneMinute      = time.Minute
+	FiveMinutes    = 5 * time.Minute
+	FifteenMinutes = 15 * time.Minute
+)
+
+// Bar is a candle of one symbol and interval. Candle.Time is the start of the
+// interval, aligned to midnight Nepal time as by nepse.CandleBucket.
+type Bar struct {
+	Symbol   string        `json:"symbol"`
+	Interval time.Duration `json:"interval"`
+	nepse.Candle
+}
+
+// barKey identifies the bar in progress of a symbol and interval
+type barKey struct {
+	symbol   string
+	interval time.Duration
+}
+
+// Builder aggregates ticks into bars. It is safe for concurrent use, but
+// completed bars are sent on a channel that must be drained: adding a tick
+// blocks while the channel is full.
+type Builder struct {
+	intervals []time.Duration
+	bars      chan Bar
+
+	mu      sync.Mutex
+	current map[barKey]*Bar
+	// sent is the start of the last bar sent per key, to drop late ticks
+	sent map[barKey]time.Time
+	// volumes is the session volume of each symbol's last quote
+	volumes map[string]int64
+	closed  bool
+
+	// sendMu serialises sends on bars with closing it
+	sendMu     sync.Mutex
+	barsClosed bool
+	// abort is closed when the ctx of Close is done, to unblock pending sends
+	abort chan struct{}
+}
+
+// NewBuilder returns a builder of bars of the given intervals (one minute if
+// none are given) whose channel buffers buffer completed bars
+func NewBuilder(buffer int, intervals ...time.Duration) *Builder {
+	intervals = slices.DeleteFunc(slices.Clone(intervals), func(d time.Duration) bool { return d <= 0 })
+	if len(intervals) == 0 {
+		intervals = []time.Duration{OneMinute}
+	}
+	slices.Sort(intervals)
+	return &Builder{
+		intervals: slices.Compact(intervals),
+		bars:      make(chan Bar, max(buffer, 0)),
+		current:   make(map[barKey]*Bar),
+		sent:      make(map[barKey]time.Time),
+		volumes:   make(map[string]int64),
+		abort:     make(chan struct{}),
+	}
+}
+
+// Bars returns the channel completed bars are sent on, oldest first per symbol
+// and interval. It is closed by Close.
+func (b *Builder) Bars() <-chan Bar {
+	return b.bars
+}
+
+// AddTrade records a trade of quantity shares at price, made at the given time
+func (b *Builder) AddTrade(symbol string, price float64, quantity int64, at time.Time) {
+	if price <= 0 {
+		return
+	}
+	b.emit(context.Background(), b.add(nepse.NormalizeSymbol(symbol), price, quantity, at))
+}
+
+// AddFloorSheet records floor sheet trades, e.g. the batches of
+// TailFloorSheet, at the given time. The floor sheet's trade times are not
+// precise enough to place trades, so pass the time the batch was received.
+func (b *Builder) AddFloorSheet(trades []nepse.FloorSheetEntry, at time.Time) {
+	var done []Bar
+	for i := range trades {
+		t := &trades[i]
+		if t.ContractRate > 0 {
+			done = append(done, b.add(nepse.NormalizeSymbol(t.StockSymbol), t.ContractRate, t.ContractQuantity, at)...)
+		}
+	}
+	b.emit(context.Background(), done)
+}
+
+// AddQuote records a polled quote taken at the given time. Only quotes whose
+// session volume rose since the symbol's previous quote count as ticks, with
+// the volume difference traded at the last traded price; the first quote of a
+// symbol sets the baseline.
+func (b *Builder) AddQuote(q nepse.Quote, at time.Time) {
+	b.addQuote(context.Background(), q, at)
+}
+
+// addQuote records a quote like AddQuote, giving up sending bars once ctx is done
+func (b *Builder) addQuote(ctx context.Context, q nepse.Quote, at time.Time) {
+	if q.LastTradedPrice <= 0 {
+		return
+	}
+	symbol := nepse.NormalizeSymbol(q.Symbol)
+	b.mu.Lock()
+	last, seen := b.volumes[symbol]
+	b.volumes[symbol] = q.Volume
+	b.mu.Unlock()
+
+	traded := q.Volume - last
+	if q.Volume < last {
+		// A new session started since the previous quote
+		traded = q.Volume
+	}
+	if !seen || traded <= 0 {
+		return
+	}
+	b.emit(ctx, b.add(symbol, q.LastTradedPrice, traded, at))
+}
+
+// Current returns the bar in progress of a symbol and interval
+func (b *Builder) Current(symbol string, interval time.Duration) (Bar, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	bar, ok := b.current[barKey{nepse.NormalizeSymbol(symbol), interval}]
+	if !ok {
+		return Bar{}, false
+	}
+	return *bar, true
+}
+
+// Flush sends the bars whose interval ended by now. Adding a tick flushes the
+// bars of its own symbol; Flush completes bars of symbols that stopped trading.
+func (b *Builder) Flush(now time.Time) {
+	b.flush(context.Background(), now)
+}
+
+// flush sends bars like Flush, giving up once ctx is done
+func (b *Builder) flush(ctx context.Context, now time.Time) {
+	b.mu.Lock()
+	var done []Bar
+	for key, bar := range b.current {
+		if !now.Before(bar.Time.Add(key.interval)) {
+			done = append(done, *bar)
+			delete(b.current, key)
+			b.sent[key] = bar.Time
+		}
+	}
+	b.mu.Unlock()
+	b.emit(ctx, sortBars(done))
+}
+
+// Close sends every bar still in progress and closes the Bars channel. Ticks
+// added afterwards are ignored. Close waits for room on a full channel until
+// ctx is done; the bars that don't fit by then, its own or those of ticks being
+// added concurrently, are dropped.
+func (b *Builder) Close(ctx context.Context) {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return
+	}
+	b.closed = true
+	done := make([]Bar, 0, len(b.current))
+	for _, bar := range b.current {
+		done = append(done, *bar)
+	}
+	clear(b.current)
+	b.mu.Unlock()
+
+	stop := context.AfterFunc(ctx, func() { close(b.abort) })
+	defer stop()
+	b.sendMu.Lock()
+	defer b.sendMu.Unlock()
+	b.send(ctx, sortBars(done))
+	b.barsClosed = true
+	close(b.bars)
+}
+
+// Run feeds the builder from the live market, polled every interval through
+// client.SubscribeLiveMarket, until ctx is done, flushing bars as their
+// intervals end. Failed polls are retried by the subscription. Run returns
+// ctx's error and leaves the builder open; call Close to complete the last bars.
+// Once ctx is done, Run stops waiting for the Bars channel and drops the bars
+// it could not send.
+func (b *Builder) Run(ctx context.Context, client nepse.Client, interval time.Duration) error {
+	updates, err := client.SubscribeLiveMarket(ctx, interval)
+	if err != nil {
+		return err
+	}
+	timer := time.NewTimer(b.untilBoundary(time.Now()))
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case u, ok := <-updates:
+			if !ok {
+				return ctx.Err()
+			}
+			if u.Err != nil {
+				continue
+			}
+			for i := range u.Entries {
+				b.addQuote(ctx, u.Entries[i].Quote(), u.Time)
+			}
+		case now := <-timer.C:
+			b.flush(ctx, now)
+			timer.Reset(b.untilBoundary(time.Now()))
+		}
+	}
+}
+
+// builderState is the persisted position of a Builder
+type builderState struct {
+	Current []Bar            `json:"current"`
+	Sent    []Bar            `json:"sent"`
+	Volumes map[string]int64 `json:"volumes"`
+}
+
+// Save stores the builder's position under key: the bars in progress, the last
+// bar sent per symbol and interval, and the last quoted volumes. Call it after
+// persisting each completed bar; a builder restored from it continues the bars
+// in progress and never sends a bar again.
+func (b *Builder) Save(ctx context.Context, store nepse.Store, key string) error {
+	b.mu.Lock()
+	state := builderState{Volumes: maps.Clone(b.volumes)}
+	for _, bar := range b.current {
+		state.Current = append(state.Current, *bar)
+	}
+	for k, start := range b.sent {
+		state.Sent = append(state.Sent, Bar{Symbol: k.symbol, Interval: k.interval, Candle: nepse.Candle{Time: start}})
+	}
+	b.mu.Unlock()
+
+	value, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to encode candle builder state: %w", err)
+	}
+	if err := store.Save(ctx, key, value); err != nil {
+		return fmt.Errorf("failed to save candle builder state: %w", err)
+	}
+	return nil
+}
+
+// Restore replaces the builder's position with the one saved under key. It
+// does nothing if nothing was saved yet. Bars of intervals the builder doesn't
+// build are ignored.
+func (b *Builder) Restore(ctx context.Context, store nepse.Store, key string) error {
+	value, err := store.Load(ctx, key)
+	if errors.Is(err, nepse.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load candle builder state: %w", err)
+	}
+	var state builderState
+	if err := json.Unmarshal(value, &state); err != nil {
+		return fmt.Errorf("failed to decode candle builder state: %w", err)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	clear(b.current)
+	clear(b.sent)
+	clear(b.volumes)
+	for _, bar := range state.Current {
+		if slices.Contains(b.intervals, bar.Interval) {
+			b.current[barKey{bar.Symbol, bar.Interval}] = &bar
+		}
+	}
+	for _, bar := range state.Sent {
+		if slices.Contains(b.intervals, bar.Interval) {
+			b.sent[barKey{bar.Symbol, bar.Interval}] = bar.Time
+		}
+	}
+	maps.Copy(b.volumes, state.Volumes)
+	return nil
+}
+
+// add applies a tick and returns the bars of the symbol it completed
+func (b *Builder) add(symbol string, price float64, quantity int64, at time.Time) []Bar {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return nil
+	}
+	var done []Bar
+	for _, interval := range b.intervals {
+		key := barKey{symbol, interval}
+		start := nepse.CandleBucket(at, interval)
+		if sent, ok := b.sent[key]; ok && !start.After(sent) {
+			// A late tick for a bar already sent
+			continue
+		}
+		bar, ok := b.current[key]
+		if ok && start.Before(bar.Time) {
+			continue
+		}
+		if ok && start.After(bar.Time) {
+			done = append(done, *bar)
+			b.sent[key] = bar.Time
+			ok = false
+		}
+		if !ok {
+			bar = &Bar{Symbol: symbol, Interval: interval, Candle: nepse.Candle{Time: start, Open: price, High: price, Low: price}}
+			b.current[key] = bar
+		}
+		bar.High = max(bar.High, price)
+		bar.Low = min(bar.Low, price)
+		bar.Close = price
+		bar.Volume += float64(max(quantity, 0))
+	}
+	return done
+}
+
+// emit sends completed bars, blocking while the channel is full until ctx is
+// done or Close gives up
+func (b *Builder) emit(ctx context.Context, bars []Bar) {
+	if len(bars) == 0 {
+		return
+	}
+	b.sendMu.Lock()
+	defer b.sendMu.Unlock()
+	if b.barsClosed {
+		return
+	}
+	b.send(ctx, bars)
+}
+
+// send sends bars on the channel until ctx is done or Close gives up. The
+// caller holds sendMu.
+func (b *Builder) send(ctx context.Context, bars []Bar) {
+	for _, bar := range bars {
+		select {
+		case b.bars <- bar:
+		case <-ctx.Done():
+			return
+		case <-b.abort:
+			return
+		}
+	}
+}
+
+// untilBoundary returns how long until the shortest interval's next bar starts
+func (b *Builder) untilBoundary(now time.Time) time.Duration {
+	interval := b.intervals[0]
+	return nepse.CandleBucket(now, interval).Add(interval).Sub(now)
+}
+
+// sortBars orders bars by start time, then symbol and interval
+func sortBars(bars []Bar) []Bar {
+	slices.SortFunc(bars, func(x, y Bar) int {
+		if c := x.Time.Compare(y.Time); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(x.Symbol, y.Symbol); c != 0 {
+			return c
+		}
+		return cmp.Compare(x.Interval, y.Interval)
+	})
+	return bars
+}
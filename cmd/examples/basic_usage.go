@@ -124,13 +124,16 @@ func main() {
 	// Decide effective business date:
 	// - If user provided one, use it
 	// - Else if market is open, use today
-	// - Else use last weekday
+	// - Else use the last trading day per the NEPSE holiday calendar
 	effBizDate := userBizDate
 	if effBizDate == "" {
 		if marketOpen {
 			effBizDate = today
+		} else if d, err := client.LastTradingDay(ctx); err != nil {
+			log.Printf("Last trading day: %v", err)
+			effBizDate = today
 		} else {
-			effBizDate = lastWeekday(now).Format("2006-01-02")
+			effBizDate = d.Format("2006-01-02")
 		}
 	}
 	if todays, err := client.GetTodaysPrices(ctx, effBizDate); err != nil {
@@ -295,15 +298,3 @@ func main() {
 
 	fmt.Println("\n🎉 Finished exercising all public APIs.")
 }
-
-// lastWeekday returns the last weekday date (Fri for Sat/Sun, else same day)
-func lastWeekday(t time.Time) time.Time {
-	switch t.Weekday() {
-	case time.Saturday:
-		return t.AddDate(0, 0, -1)
-	case time.Sunday:
-		return t.AddDate(0, 0, -2)
-	default:
-		return t
-	}
-}
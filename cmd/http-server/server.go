@@ -3,14 +3,18 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/voidarchive/nepseauth/nepse"
+	"github.com/voidarchive/nepseauth/server"
 )
 
 type app struct {
@@ -57,11 +61,40 @@ func main() {
 	mux.HandleFunc("/test/top/gainers", a.handleTopGainers)
 	mux.HandleFunc("/test/security/", a.handleSecurityRoutes)
 
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Live data (Server-Sent Events), polled until shutdown
+	live := server.New(c, server.Options{AllowOrigin: getenv("CORS_ORIGIN", "")})
+	liveDone := make(chan struct{})
+	go func() {
+		defer close(liveDone)
+		if err := live.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			log.Printf("live data stopped: %v", err)
+		}
+	}()
+	mux.Handle("/stream/", live)
+
 	addr := fmt.Sprintf("%s:%s", host, port)
-	log.Printf("listening on http://%s (docs: http://%s/docs)", addr, addr)
-	if err := http.ListenAndServe(addr, logRequests(mux)); err != nil {
+	srv := &http.Server{Addr: addr, Handler: logRequests(mux), ReadHeaderTimeout: 10 * time.Second}
+	errc := make(chan error, 1)
+	go func() {
+		log.Printf("listening on http://%s (docs: http://%s/docs)", addr, addr)
+		errc <- srv.ListenAndServe()
+	}()
+	select {
+	case err := <-errc:
 		log.Fatal(err)
+	case <-ctx.Done():
+	}
+
+	// Run closes the live streams as ctx is done, so Shutdown need not wait for them
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		log.Printf("shutdown: %v", err)
 	}
+	<-liveDone
 }
 
 func (a *app) handleMarketSummary(w http.ResponseWriter, r *http.Request) {
@@ -249,6 +282,20 @@ const openapiJSON = `{
     "/test/top/gainers": {
       "get": {"summary": "Top gainers", "responses": {"200": {"description": "OK"}}}
     },
+    "/stream/index": {
+      "get": {
+        "summary": "Index changes as Server-Sent Events",
+        "parameters": [{"name":"names","in":"query","required":false,"schema":{"type":"string"},"description":"Comma-separated, e.g. nepse,sensitive"}],
+        "responses": {"200": {"description": "text/event-stream of index events"}}
+      }
+    },
+    "/stream/quotes": {
+      "get": {
+        "summary": "Quote changes as Server-Sent Events",
+        "parameters": [{"name":"symbols","in":"query","required":false,"schema":{"type":"string"},"description":"Comma-separated, e.g. NABIL,NICA"}],
+        "responses": {"200": {"description": "text/event-stream of quote events"}}
+      }
+    },
     "/test/security/{symbol}/company": {
       "get": {
         "summary": "Company details by symbol",
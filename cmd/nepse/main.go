@@ -0,0 +1,94 @@
+// Command nepse runs nepseauth services.
+//
+//	nepse serve proxy [-addr 127.0.0.1:8080] [-ttl 5s] [-rate 5]
+//
+// serve proxy exposes the NEPSE API locally through one shared client: local
+// consumers call NEPSE paths such as /api/nots/nepse-data/today-price on the
+// proxy without tokens or payload IDs. The client is configured by the NEPSE_*
+// environment variables.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/voidarchive/nepseauth/nepse"
+	"github.com/voidarchive/nepseauth/proxy"
+)
+
+const usage = `usage: nepse serve proxy [flags]
+
+Commands:
+  serve proxy   serve the NEPSE API locally with shared auth, caching and rate limiting
+`
+
+func main() {
+	args := os.Args[1:]
+	if len(args) < 2 || args[0] != "serve" || args[1] != "proxy" {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err := serveProxy(args[2:]); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func serveProxy(args []string) error {
+	flags := flag.NewFlagSet("serve proxy", flag.ExitOnError)
+	addr := flags.String("addr", "127.0.0.1:8080", "listen address")
+	ttl := flags.Duration("ttl", proxy.DefaultTTL, "cache TTL of live data; negative disables caching")
+	maxEntries := flags.Int("max-entries", proxy.DefaultMaxEntries, "maximum number of cached responses")
+	rate := flags.Float64("rate", 0, "upstream requests per second (NEPSE_RATE_LIMIT if zero)")
+	flags.Parse(args)
+
+	opts, err := nepse.LoadConfig()
+	if err != nil {
+		return err
+	}
+	if *rate > 0 {
+		nepse.WithRateLimit(*rate)(opts)
+	}
+	client, err := nepse.NewClient(opts)
+	if err != nil {
+		return fmt.Errorf("failed to create nepse client: %w", err)
+	}
+	defer client.Close(context.Background())
+
+	p := proxy.New(client, proxy.Options{DefaultTTL: *ttl, MaxEntries: *maxEntries})
+	mux := http.NewServeMux()
+	mux.Handle("/api/", p)
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		stats := p.Stats()
+		fmt.Fprintf(w, `{"status":"ok","hits":%d,"misses":%d,"shared":%d,"errors":%d,"entries":%d}`,
+			stats.Hits, stats.Misses, stats.Shared, stats.Errors, stats.Entries)
+	})
+	srv := &http.Server{Addr: *addr, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	errc := make(chan error, 1)
+	go func() {
+		log.Printf("nepse proxy listening on http://%s", *addr)
+		errc <- srv.ListenAndServe()
+	}()
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+	}
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
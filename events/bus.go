@@ -0,0 +1,110 @@
+package events
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Bus fans published events out to subscribers. Publishing never blocks: a
+// subscriber whose buffer is full misses the event, which is counted in Dropped,
+// so one slow consumer cannot stall the shared polling source.
+type Bus struct {
+	mu      sync.RWMutex
+	subs    map[*subscriber]struct{}
+	closed  bool
+	dropped atomic.Int64
+}
+
+// subscriber is one subscription. deliver offers it an event and reports false
+// if the event was dropped.
+type subscriber struct {
+	deliver func(Event) bool
+	close   func()
+}
+
+// NewBus returns an empty bus
+func NewBus() *Bus {
+	return &Bus{subs: make(map[*subscriber]struct{})}
+}
+
+// Publish delivers e to every subscriber interested in its type
+func (b *Bus) Publish(e Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for s := range b.subs {
+		if !s.deliver(e) {
+			b.dropped.Add(1)
+		}
+	}
+}
+
+// Dropped returns how many deliveries were skipped because a subscriber's
+// buffer was full
+func (b *Bus) Dropped() int64 {
+	return b.dropped.Load()
+}
+
+// Close closes every subscription channel. Later subscriptions are closed
+// immediately and later publishes are ignored.
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	b.closed = true
+	for s := range b.subs {
+		s.close()
+		delete(b.subs, s)
+	}
+}
+
+// add registers a subscriber and returns the function that removes it
+func (b *Bus) add(s *subscriber) (cancel func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		s.close()
+		return func() {}
+	}
+	b.subs[s] = struct{}{}
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.subs[s]; ok {
+			delete(b.subs, s)
+			s.close()
+		}
+	}
+}
+
+// Subscribe returns a channel receiving the events of type E published on b,
+// buffered to hold buffer events, and a cancel function that unsubscribes and
+// closes the channel:
+//
+//	opened, cancel := events.Subscribe[events.MarketOpened](bus, 1)
+func Subscribe[E Event](b *Bus, buffer int) (<-chan E, func()) {
+	ch := make(chan E, max(buffer, 0))
+	cancel := b.add(&subscriber{
+		deliver: func(e Event) bool {
+			typed, ok := e.(E)
+			if !ok {
+				return true
+			}
+			select {
+			case ch <- typed:
+				return true
+			default:
+				return false
+			}
+		},
+		close: func() { close(ch) },
+	})
+	return ch, cancel
+}
+
+// SubscribeAll returns a channel receiving every event published on b, like
+// Subscribe
+func SubscribeAll(b *Bus, buffer int) (<-chan Event, func()) {
+	return Subscribe[Event](b, buffer)
+}
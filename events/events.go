@@ -0,0 +1,79 @@
+// Package events publishes typed NEPSE market events to in-process subscribers.
+//
+// A Source polls the NEPSE API once and publishes what changed to a Bus, so any
+// number of consumers share one polling loop instead of each hitting the API:
+//
+//	bus := events.NewBus()
+//	ticks, cancel := events.Subscribe[events.PriceTick](bus, 256)
+//	defer cancel()
+//	go events.NewSource(client, bus, events.SourceOptions{}).Run(ctx)
+//	for tick := range ticks {
+//		fmt.Println(tick.Quote.Symbol, tick.Quote.LastTradedPrice)
+//	}
+package events
+
+import (
+	"time"
+
+	"github.com/voidarchive/nepseauth/nepse"
+)
+
+// Event is implemented by every event type of this package
+type Event interface {
+	// EventTime is when the event was observed
+	EventTime() time.Time
+}
+
+// MarketOpened is published when the market status changes to open
+type MarketOpened struct {
+	Time   time.Time          `json:"time"`
+	Status nepse.MarketStatus `json:"status"`
+}
+
+// MarketClosed is published when the market status changes to closed
+type MarketClosed struct {
+	Time   time.Time          `json:"time"`
+	Status nepse.MarketStatus `json:"status"`
+}
+
+// PriceTick is published when a security's live quote changes
+type PriceTick struct {
+	Time  time.Time   `json:"time"`
+	Quote nepse.Quote `json:"quote"`
+}
+
+// IndexTick is published when one of the headline indices changes
+type IndexTick struct {
+	Time time.Time `json:"time"`
+	// Name is "NEPSE", "Sensitive", "Float" or "Sensitive Float"
+	Name  string           `json:"name"`
+	Index nepse.NepseIndex `json:"index"`
+}
+
+// VWAPTick is published when a security's session VWAP changes. The VWAP is
+// computed from the volume traded between live market polls, so it covers the
+// trades since the Source started.
+type VWAPTick struct {
+	Time   time.Time `json:"time"`
+	Symbol string    `json:"symbol"`
+	VWAP   float64   `json:"vwap"`
+	// Volume is the traded quantity the VWAP covers
+	Volume int64 `json:"volume"`
+}
+
+// CircuitBreakerHit is published the first time in a session a security trades
+// at a limit of its daily price band
+type CircuitBreakerHit struct {
+	Time  time.Time       `json:"time"`
+	Quote nepse.Quote     `json:"quote"`
+	Band  nepse.PriceBand `json:"band"`
+	// Upper is true for the upper circuit, false for the lower one
+	Upper bool `json:"upper"`
+}
+
+func (e MarketOpened) EventTime() time.Time      { return e.Time }
+func (e MarketClosed) EventTime() time.Time      { return e.Time }
+func (e PriceTick) EventTime() time.Time         { return e.Time }
+func (e IndexTick) EventTime() time.Time         { return e.Time }
+func (e VWAPTick) EventTime() time.Time          { return e.Time }
+func (e CircuitBreakerHit) EventTime() time.Time { return e.Time }
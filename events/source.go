@@ -0,0 +1,219 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/voidarchive/nepseauth/nepse"
+)
+
+// DefaultPollInterval is the polling interval of a Source with no Interval set
+const DefaultPollInterval = 5 * time.Second
+
+// SourceOptions configures a Source
+type SourceOptions struct {
+	// Interval between polls (DefaultPollInterval if zero)
+	Interval time.Duration
+
+	// OffHoursInterval is the interval outside trading hours and on holidays
+	// (nepse.DefaultOffHoursInterval if zero); negative polls at Interval
+	// around the clock
+	OffHoursInterval time.Duration
+
+	// OnError, if set, receives the errors of failed polls. Polling continues.
+	OnError func(error)
+}
+
+// Source polls the market status, live market and headline indices and
+// publishes MarketOpened, MarketClosed, PriceTick, IndexTick, VWAPTick and
+// CircuitBreakerHit events to a bus. Transitions are published relative to the
+// first poll, so no MarketOpened is sent for a market already open at start.
+type Source struct {
+	client   nepse.Client
+	bus      *Bus
+	opts     SourceOptions
+	schedule *nepse.TradingHoursSchedule
+	vwap     *nepse.VWAPCalculator
+
+	open    bool
+	started bool
+	quotes  map[string]nepse.Quote
+	indices map[string]nepse.NepseIndex
+	// circuits records the securities that hit a circuit this session, by
+	// symbol, and whether it was the upper one
+	circuits map[string]bool
+}
+
+// NewSource returns a source publishing to bus
+func NewSource(client nepse.Client, bus *Bus, opts SourceOptions) *Source {
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultPollInterval
+	}
+	if opts.OffHoursInterval == 0 {
+		opts.OffHoursInterval = nepse.DefaultOffHoursInterval
+	}
+	var schedule *nepse.TradingHoursSchedule
+	if opts.OffHoursInterval > 0 {
+		schedule = &nepse.TradingHoursSchedule{Calendar: client.GetTradingCalendar, OffHoursInterval: opts.OffHoursInterval}
+	}
+	return &Source{
+		client:   client,
+		bus:      bus,
+		opts:     opts,
+		schedule: schedule,
+		vwap:     nepse.NewVWAPCalculator(),
+		quotes:   make(map[string]nepse.Quote),
+		indices:  make(map[string]nepse.NepseIndex),
+		circuits: make(map[string]bool),
+	}
+}
+
+// Run polls until ctx is done and returns its error. Outside trading hours it
+// polls every OffHoursInterval instead. Run must not be called concurrently on
+// one Source.
+func (s *Source) Run(ctx context.Context) error {
+	for {
+		s.poll(ctx)
+		wait := s.opts.Interval
+		if s.schedule != nil && ctx.Err() == nil {
+			wait = s.schedule.Wait(ctx, time.Now(), wait)
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// poll runs one round of requests and publishes the resulting events
+func (s *Source) poll(ctx context.Context) {
+	status, err := s.client.GetMarketStatus(ctx)
+	if err != nil {
+		s.fail(ctx, err)
+		return
+	}
+	now := time.Now().In(nepse.NepalLocation)
+	open := status.IsMarketOpen()
+	if s.started && open != s.open {
+		if open {
+			clear(s.circuits)
+			s.vwap.Reset()
+			s.bus.Publish(MarketOpened{Time: now, Status: *status})
+		} else {
+			s.bus.Publish(MarketClosed{Time: now, Status: *status})
+		}
+	}
+	wasOpen := s.open
+	s.open, s.started = open, true
+
+	// After the close one more round picks up the final prices
+	if !open && !wasOpen && len(s.quotes) > 0 {
+		return
+	}
+	s.pollIndices(ctx, now)
+	s.pollLiveMarket(ctx, now)
+}
+
+func (s *Source) pollIndices(ctx context.Context, now time.Time) {
+	indices, err := s.client.GetAllIndices(ctx)
+	if err != nil {
+		s.fail(ctx, err)
+		return
+	}
+	for _, index := range []struct {
+		name  string
+		value *nepse.NepseIndex
+	}{
+		{"NEPSE", indices.Nepse},
+		{"Sensitive", indices.Sensitive},
+		{"Float", indices.Float},
+		{"Sensitive Float", indices.SensitiveFloat},
+	} {
+		if index.value == nil {
+			continue
+		}
+		last, seen := s.indices[index.name]
+		current := *index.value
+		if seen && sameIndex(last, current) {
+			continue
+		}
+		s.indices[index.name] = current
+		s.bus.Publish(IndexTick{Time: now, Name: index.name, Index: current})
+	}
+}
+
+func (s *Source) pollLiveMarket(ctx context.Context, now time.Time) {
+	entries, err := s.client.GetLiveMarket(ctx)
+	if err != nil {
+		s.fail(ctx, err)
+		return
+	}
+	for i := range entries {
+		quote := entries[i].Quote()
+		quote.AsOf = nepse.Timestamp{Time: now}
+		last, seen := s.quotes[quote.Symbol]
+		if seen && sameQuote(last, quote) {
+			continue
+		}
+		s.quotes[quote.Symbol] = quote
+		s.bus.Publish(PriceTick{Time: now, Quote: quote})
+		s.checkCircuit(quote, now)
+		s.updateVWAP(quote, now)
+	}
+}
+
+// checkCircuit publishes a CircuitBreakerHit the first time in a session a
+// quote reaches either limit of its price band
+func (s *Source) checkCircuit(quote nepse.Quote, now time.Time) {
+	if quote.PreviousClose <= 0 || quote.LastTradedPrice <= 0 {
+		return
+	}
+	band := nepse.NewPriceBand(quote.PreviousClose, 0)
+	band.Symbol = quote.Symbol
+	var upper bool
+	switch {
+	case quote.LastTradedPrice >= band.Upper:
+		upper = true
+	case quote.LastTradedPrice <= band.Lower:
+		upper = false
+	default:
+		return
+	}
+	if hitUpper, hit := s.circuits[quote.Symbol]; hit && hitUpper == upper {
+		return
+	}
+	s.circuits[quote.Symbol] = upper
+	s.bus.Publish(CircuitBreakerHit{Time: now, Quote: quote, Band: *band, Upper: upper})
+}
+
+// updateVWAP feeds a changed quote to the VWAP calculator and publishes a
+// VWAPTick if the quote carried new volume
+func (s *Source) updateVWAP(quote nepse.Quote, now time.Time) {
+	_, before, _ := s.vwap.VWAP(quote.Symbol)
+	s.vwap.AddQuote(quote)
+	vwap, volume, ok := s.vwap.VWAP(quote.Symbol)
+	if ok && volume != before {
+		s.bus.Publish(VWAPTick{Time: now, Symbol: quote.Symbol, VWAP: vwap, Volume: volume})
+	}
+}
+
+func (s *Source) fail(ctx context.Context, err error) {
+	if ctx.Err() == nil && s.opts.OnError != nil {
+		s.opts.OnError(err)
+	}
+}
+
+// sameQuote reports whether two quotes carry the same market data
+func sameQuote(a, b nepse.Quote) bool {
+	a.AsOf, b.AsOf = nepse.Timestamp{}, nepse.Timestamp{}
+	return a == b
+}
+
+// sameIndex reports whether two index readings have the same values
+func sameIndex(a, b nepse.NepseIndex) bool {
+	a.GeneratedTime, b.GeneratedTime = nepse.Timestamp{}, nepse.Timestamp{}
+	return a == b
+}
@@ -0,0 +1,512 @@
+// Package mqtt publishes NEPSE market events to an MQTT broker such as
+// Mosquitto, for home-automation and IoT dashboards.
+//
+// A Sink forwards the events of an events.Bus to one topic per symbol and event
+// kind, and Client is a small dependency-free MQTT 3.1.1 publisher:
+//
+//	client := mqtt.NewClient(mqtt.Config{Broker: "tcp://localhost:1883", ClientID: "nepse"})
+//	defer client.Close()
+//	sink := mqtt.NewSink(client, mqtt.SinkOptions{QoS: 1, Retain: true})
+//	go sink.Run(ctx, bus)
+//
+// Quotes are then published to nepse/quote/NABIL, index readings to
+// nepse/index/sensitive and circuit breaker alerts to nepse/alert/circuit/NABIL.
+package mqtt
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultKeepAlive is the keep-alive interval of a Client with none set
+const DefaultKeepAlive = 60 * time.Second
+
+// writeTimeout bounds a packet write whose context has no earlier deadline, so
+// a stalled broker breaks the connection instead of blocking every publisher
+const writeTimeout = 30 * time.Second
+
+// Message is a message to publish
+type Message struct {
+	Topic   string
+	Payload []byte
+	// QoS is 0 (at most once) or 1 (at least once)
+	QoS byte
+	// Retain asks the broker to keep the message for later subscribers
+	Retain bool
+}
+
+// Publisher publishes messages to a broker. Client implements it; other MQTT
+// libraries can be adapted to feed a Sink.
+type Publisher interface {
+	Publish(ctx context.Context, msg Message) error
+}
+
+// Config configures a Client
+type Config struct {
+	// Broker is the broker address: "host:port", or a URL with the tcp, mqtt,
+	// ssl, tls or mqtts scheme. The port defaults to 1883, or 8883 with TLS.
+	Broker string
+
+	ClientID string
+	Username string
+	Password string
+
+	// KeepAlive is the interval of keep-alive pings (DefaultKeepAlive if zero)
+	KeepAlive time.Duration
+
+	// TLS, if set, is used to connect to brokers without a TLS scheme too
+	TLS *tls.Config
+
+	// Dialer, if set, replaces the default net.Dialer
+	Dialer *net.Dialer
+}
+
+// Client is an MQTT 3.1.1 client that only publishes. It connects on the first
+// publish and reconnects on the next publish after the connection breaks, with
+// a clean session each time. Safe for concurrent use.
+type Client struct {
+	config Config
+
+	mu     sync.Mutex
+	conn   *conn
+	closed bool
+}
+
+// NewClient returns a client for the broker of config. It does not connect yet.
+func NewClient(config Config) *Client {
+	if config.KeepAlive <= 0 {
+		config.KeepAlive = DefaultKeepAlive
+	}
+	return &Client{config: config}
+}
+
+// Connect connects to the broker if the client is not connected yet. Publish
+// connects on its own; Connect lets callers fail fast on a bad configuration.
+func (c *Client) Connect(ctx context.Context) error {
+	_, err := c.connection(ctx)
+	return err
+}
+
+// Publish sends msg to the broker. With QoS 1 it waits until the broker
+// acknowledges the message.
+func (c *Client) Publish(ctx context.Context, msg Message) error {
+	if msg.QoS > 1 {
+		return fmt.Errorf("mqtt: unsupported QoS %d", msg.QoS)
+	}
+	if msg.Topic == "" || strings.ContainsAny(msg.Topic, "+#") {
+		return fmt.Errorf("mqtt: invalid topic %q", msg.Topic)
+	}
+	cn, err := c.connection(ctx)
+	if err != nil {
+		return err
+	}
+	if err := cn.publish(ctx, msg); err != nil {
+		c.drop(cn)
+		return fmt.Errorf("mqtt: failed to publish to %q: %w", msg.Topic, err)
+	}
+	return nil
+}
+
+// Close disconnects from the broker. Later publishes fail.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	if c.conn == nil {
+		return nil
+	}
+	err := c.conn.disconnect()
+	c.conn = nil
+	return err
+}
+
+// connection returns the current connection, dialing a new one if needed
+func (c *Client) connection(ctx context.Context) (*conn, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil, errors.New("mqtt: client closed")
+	}
+	if c.conn != nil && !c.conn.broken() {
+		return c.conn, nil
+	}
+	if c.conn != nil {
+		c.conn.close()
+		c.conn = nil
+	}
+	cn, err := dial(ctx, c.config)
+	if err != nil {
+		return nil, err
+	}
+	c.conn = cn
+	return cn, nil
+}
+
+// drop closes cn if it is still the current connection
+func (c *Client) drop(cn *conn) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conn == cn {
+		c.conn = nil
+	}
+	cn.close()
+}
+
+// MQTT control packet types
+const (
+	packetConnect    = 1
+	packetConnAck    = 2
+	packetPublish    = 3
+	packetPubAck     = 4
+	packetPingReq    = 12
+	packetPingResp   = 13
+	packetDisconnect = 14
+)
+
+// connAckErrors are the reasons of the CONNACK return codes
+var connAckErrors = map[byte]string{
+	1: "unacceptable protocol version",
+	2: "client identifier rejected",
+	3: "server unavailable",
+	4: "bad user name or password",
+	5: "not authorized",
+}
+
+// conn is one connection to the broker
+type conn struct {
+	nc net.Conn
+
+	// writing holds a token while a packet is written
+	writing chan struct{}
+
+	mu      sync.Mutex
+	nextID  uint16
+	pending map[uint16]chan struct{}
+	err     error
+
+	done chan struct{}
+}
+
+// dial connects and completes the MQTT handshake
+func dial(ctx context.Context, config Config) (*conn, error) {
+	address, useTLS, err := brokerAddress(config.Broker)
+	if err != nil {
+		return nil, err
+	}
+	dialer := config.Dialer
+	if dialer == nil {
+		dialer = &net.Dialer{}
+	}
+	var nc net.Conn
+	if useTLS || config.TLS != nil {
+		tlsConfig := config.TLS
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{}
+		}
+		nc, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", address)
+	} else {
+		nc, err = dialer.DialContext(ctx, "tcp", address)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("mqtt: failed to connect to %s: %w", address, err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		nc.SetDeadline(deadline)
+	}
+	r := bufio.NewReader(nc)
+	if _, err := nc.Write(connectPacket(config)); err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("mqtt: failed to connect to %s: %w", address, err)
+	}
+	kind, body, err := readPacket(r)
+	if err == nil && (kind != packetConnAck || len(body) != 2) {
+		err = fmt.Errorf("unexpected packet type %d", kind)
+	}
+	if err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("mqtt: failed to connect to %s: %w", address, err)
+	}
+	if code := body[1]; code != 0 {
+		nc.Close()
+		reason, ok := connAckErrors[code]
+		if !ok {
+			reason = fmt.Sprintf("return code %d", code)
+		}
+		return nil, fmt.Errorf("mqtt: connection to %s refused: %s", address, reason)
+	}
+	nc.SetDeadline(time.Time{})
+
+	cn := &conn{
+		nc:      nc,
+		writing: make(chan struct{}, 1),
+		pending: make(map[uint16]chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go cn.read(r)
+	go cn.keepAlive(config.KeepAlive)
+	return cn, nil
+}
+
+// publish writes a PUBLISH packet and, with QoS 1, waits for its PUBACK
+func (cn *conn) publish(ctx context.Context, msg Message) error {
+	var id uint16
+	var acked chan struct{}
+	if msg.QoS > 0 {
+		cn.mu.Lock()
+		if cn.err != nil {
+			cn.mu.Unlock()
+			return cn.err
+		}
+		cn.nextID++
+		if cn.nextID == 0 {
+			cn.nextID = 1
+		}
+		id = cn.nextID
+		acked = make(chan struct{})
+		cn.pending[id] = acked
+		cn.mu.Unlock()
+		defer func() {
+			cn.mu.Lock()
+			delete(cn.pending, id)
+			cn.mu.Unlock()
+		}()
+	}
+
+	if err := cn.write(ctx, publishPacket(msg, id)); err != nil {
+		return err
+	}
+	if acked == nil {
+		return nil
+	}
+	select {
+	case <-acked:
+		return nil
+	case <-cn.done:
+		return cn.failure()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// write writes one packet, waiting for other writes until ctx is done. The
+// write itself must finish by ctx's deadline, or within writeTimeout; a write
+// that does not breaks the connection, as the packet may be cut short.
+func (cn *conn) write(ctx context.Context, packet []byte) error {
+	select {
+	case cn.writing <- struct{}{}:
+	case <-cn.done:
+		return cn.failure()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-cn.writing }()
+
+	deadline := time.Now().Add(writeTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	cn.nc.SetWriteDeadline(deadline)
+	if _, err := cn.nc.Write(packet); err != nil {
+		cn.fail(err)
+		return err
+	}
+	return nil
+}
+
+// read handles the broker's packets until the connection fails
+func (cn *conn) read(r *bufio.Reader) {
+	for {
+		kind, body, err := readPacket(r)
+		if err != nil {
+			cn.fail(err)
+			return
+		}
+		if kind == packetPubAck && len(body) == 2 {
+			id := binary.BigEndian.Uint16(body)
+			cn.mu.Lock()
+			if acked, ok := cn.pending[id]; ok {
+				close(acked)
+				delete(cn.pending, id)
+			}
+			cn.mu.Unlock()
+		}
+	}
+}
+
+// keepAlive pings the broker every interval so it keeps the connection open
+func (cn *conn) keepAlive(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-cn.done:
+			return
+		case <-ticker.C:
+			if cn.write(context.Background(), []byte{packetPingReq << 4, 0}) != nil {
+				return
+			}
+		}
+	}
+}
+
+// fail marks the connection broken with err and closes it
+func (cn *conn) fail(err error) {
+	cn.mu.Lock()
+	defer cn.mu.Unlock()
+	if cn.err != nil {
+		return
+	}
+	if errors.Is(err, io.EOF) {
+		err = errors.New("connection closed by broker")
+	}
+	cn.err = err
+	close(cn.done)
+	cn.nc.Close()
+}
+
+func (cn *conn) failure() error {
+	cn.mu.Lock()
+	defer cn.mu.Unlock()
+	return cn.err
+}
+
+func (cn *conn) broken() bool {
+	return cn.failure() != nil
+}
+
+func (cn *conn) close() {
+	cn.fail(net.ErrClosed)
+}
+
+// disconnect sends DISCONNECT and closes the connection
+func (cn *conn) disconnect() error {
+	if cn.broken() {
+		return nil
+	}
+	err := cn.write(context.Background(), []byte{packetDisconnect << 4, 0})
+	cn.close()
+	return err
+}
+
+// brokerAddress returns the host:port of a broker address and whether its
+// scheme asks for TLS
+func brokerAddress(broker string) (address string, useTLS bool, err error) {
+	if scheme, rest, ok := strings.Cut(broker, "://"); ok {
+		switch strings.ToLower(scheme) {
+		case "tcp", "mqtt":
+		case "ssl", "tls", "mqtts":
+			useTLS = true
+		default:
+			return "", false, fmt.Errorf("mqtt: unsupported broker scheme %q", scheme)
+		}
+		broker = strings.TrimSuffix(rest, "/")
+	}
+	if broker == "" {
+		return "", false, errors.New("mqtt: broker address is required")
+	}
+	if _, _, err := net.SplitHostPort(broker); err != nil {
+		port := "1883"
+		if useTLS {
+			port = "8883"
+		}
+		broker = net.JoinHostPort(broker, port)
+	}
+	return broker, useTLS, nil
+}
+
+// connectPacket encodes a CONNECT packet with a clean session
+func connectPacket(config Config) []byte {
+	flags := byte(0x02)
+	if config.Username != "" {
+		flags |= 0x80
+	}
+	if config.Password != "" {
+		flags |= 0x40
+	}
+	body := appendString(nil, "MQTT")
+	body = append(body, 4, flags)
+	body = binary.BigEndian.AppendUint16(body, uint16(min(config.KeepAlive/time.Second, 0xffff)))
+	body = appendString(body, config.ClientID)
+	if config.Username != "" {
+		body = appendString(body, config.Username)
+	}
+	if config.Password != "" {
+		body = appendString(body, config.Password)
+	}
+	return appendPacket(nil, packetConnect<<4, body)
+}
+
+// publishPacket encodes a PUBLISH packet; id is only sent with QoS 1
+func publishPacket(msg Message, id uint16) []byte {
+	header := byte(packetPublish<<4) | msg.QoS<<1
+	if msg.Retain {
+		header |= 0x01
+	}
+	body := appendString(make([]byte, 0, 2+len(msg.Topic)+2+len(msg.Payload)), msg.Topic)
+	if msg.QoS > 0 {
+		body = binary.BigEndian.AppendUint16(body, id)
+	}
+	body = append(body, msg.Payload...)
+	return appendPacket(nil, header, body)
+}
+
+// appendPacket appends a packet with its fixed header
+func appendPacket(b []byte, header byte, body []byte) []byte {
+	b = append(b, header)
+	n := len(body)
+	for {
+		digit := byte(n % 128)
+		n /= 128
+		if n > 0 {
+			digit |= 0x80
+		}
+		b = append(b, digit)
+		if n == 0 {
+			break
+		}
+	}
+	return append(b, body...)
+}
+
+// appendString appends a length-prefixed UTF-8 string
+func appendString(b []byte, s string) []byte {
+	b = binary.BigEndian.AppendUint16(b, uint16(len(s)))
+	return append(b, s...)
+}
+
+// readPacket reads one packet and returns its type and body
+func readPacket(r *bufio.Reader) (byte, []byte, error) {
+	header, err := r.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	var length, shift int
+	for {
+		digit, err := r.ReadByte()
+		if err != nil {
+			return 0, nil, err
+		}
+		length |= int(digit&0x7f) << shift
+		if digit&0x80 == 0 {
+			break
+		}
+		if shift += 7; shift > 21 {
+			return 0, nil, errors.New("malformed packet length")
+		}
+	}
+	body := make([]byte, length)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return 0, nil, err
+	}
+	return header >> 4, body, nil
+}
@@ -0,0 +1,268 @@
+package mqtt
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeBroker accepts one connection and hands its packets to the test
+type fakeBroker struct {
+	t  *testing.T
+	ln net.Listener
+	nc chan net.Conn
+}
+
+func newFakeBroker(t *testing.T) *fakeBroker {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := &fakeBroker{t: t, ln: ln, nc: make(chan net.Conn, 1)}
+	go func() {
+		nc, err := ln.Accept()
+		if err == nil {
+			b.nc <- nc
+		}
+	}()
+	t.Cleanup(func() { ln.Close() })
+	return b
+}
+
+// accept waits for the client's CONNECT and answers it with return code
+func (b *fakeBroker) accept(code byte) (net.Conn, *bufio.Reader, []byte) {
+	b.t.Helper()
+	var nc net.Conn
+	select {
+	case nc = <-b.nc:
+	case <-time.After(5 * time.Second):
+		b.t.Fatal("client did not connect")
+	}
+	b.t.Cleanup(func() { nc.Close() })
+	r := bufio.NewReader(nc)
+	kind, body := b.read(r)
+	if kind != packetConnect {
+		b.t.Fatalf("first packet type %d, want CONNECT", kind)
+	}
+	if _, err := nc.Write([]byte{packetConnAck << 4, 2, 0, code}); err != nil {
+		b.t.Fatal(err)
+	}
+	return nc, r, body
+}
+
+func (b *fakeBroker) read(r *bufio.Reader) (byte, []byte) {
+	b.t.Helper()
+	kind, body, err := readPacket(r)
+	if err != nil {
+		b.t.Fatalf("broker read: %v", err)
+	}
+	return kind, body
+}
+
+func TestConnectPacket(t *testing.T) {
+	broker := newFakeBroker(t)
+	client := NewClient(Config{
+		Broker:    "tcp://" + broker.ln.Addr().String(),
+		ClientID:  "nepse",
+		Username:  "user",
+		Password:  "secret",
+		KeepAlive: 90 * time.Second,
+	})
+	defer client.Close()
+
+	done := make(chan error, 1)
+	go func() { done <- client.Connect(context.Background()) }()
+	_, _, body := broker.accept(0)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	want := []byte{0, 4, 'M', 'Q', 'T', 'T', 4, 0xc2, 0, 90}
+	want = appendString(want, "nepse")
+	want = appendString(want, "user")
+	want = appendString(want, "secret")
+	if !bytes.Equal(body, want) {
+		t.Errorf("CONNECT body = % x, want % x", body, want)
+	}
+}
+
+func TestConnectRefused(t *testing.T) {
+	broker := newFakeBroker(t)
+	client := NewClient(Config{Broker: broker.ln.Addr().String(), ClientID: "nepse"})
+	defer client.Close()
+
+	done := make(chan error, 1)
+	go func() { done <- client.Connect(context.Background()) }()
+	broker.accept(4)
+	err := <-done
+	if err == nil || !strings.Contains(err.Error(), "bad user name or password") {
+		t.Fatalf("Connect = %v, want a bad credentials refusal", err)
+	}
+}
+
+func TestPublish(t *testing.T) {
+	broker := newFakeBroker(t)
+	client := NewClient(Config{Broker: broker.ln.Addr().String(), ClientID: "nepse"})
+	defer client.Close()
+
+	// QoS 0: sent without a packet ID, no acknowledgement awaited
+	done := make(chan error, 1)
+	go func() {
+		done <- client.Publish(context.Background(), Message{Topic: "nepse/quote/NABIL", Payload: []byte(`{"ltp":525.5}`), Retain: true})
+	}()
+	nc, r, _ := broker.accept(0)
+	kind, body := broker.read(r)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if kind != packetPublish {
+		t.Fatalf("packet type %d, want PUBLISH", kind)
+	}
+	want := append(appendString(nil, "nepse/quote/NABIL"), `{"ltp":525.5}`...)
+	if !bytes.Equal(body, want) {
+		t.Errorf("QoS 0 PUBLISH body = %q, want %q", body, want)
+	}
+
+	// QoS 1: Publish returns once the broker acknowledges its packet ID
+	go func() {
+		done <- client.Publish(context.Background(), Message{Topic: "nepse/index/nepse", Payload: []byte("2071.5"), QoS: 1})
+	}()
+	kind, body = broker.read(r)
+	if kind != packetPublish {
+		t.Fatalf("packet type %d, want PUBLISH", kind)
+	}
+	topicLen := 2 + len("nepse/index/nepse")
+	id := binary.BigEndian.Uint16(body[topicLen:])
+	if got := string(body[topicLen+2:]); got != "2071.5" {
+		t.Errorf("QoS 1 payload = %q, want %q", got, "2071.5")
+	}
+	select {
+	case err := <-done:
+		t.Fatalf("Publish returned %v before PUBACK", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	// An acknowledgement of another ID does not complete it
+	nc.Write([]byte{packetPubAck << 4, 2, byte((id + 1) >> 8), byte(id + 1)})
+	nc.Write([]byte{packetPubAck << 4, 2, byte(id >> 8), byte(id)})
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPublishHeaderFlags(t *testing.T) {
+	tests := []struct {
+		msg    Message
+		header byte
+	}{
+		{Message{Topic: "a"}, 0x30},
+		{Message{Topic: "a", Retain: true}, 0x31},
+		{Message{Topic: "a", QoS: 1}, 0x32},
+		{Message{Topic: "a", QoS: 1, Retain: true}, 0x33},
+	}
+	for _, tt := range tests {
+		if got := publishPacket(tt.msg, 1)[0]; got != tt.header {
+			t.Errorf("header of %+v = %#x, want %#x", tt.msg, got, tt.header)
+		}
+	}
+}
+
+func TestRemainingLength(t *testing.T) {
+	tests := []struct {
+		length int
+		want   []byte
+	}{
+		{0, []byte{0x00}},
+		{127, []byte{0x7f}},
+		{128, []byte{0x80, 0x01}},
+		{321, []byte{0xc1, 0x02}},
+		{16383, []byte{0xff, 0x7f}},
+		{16384, []byte{0x80, 0x80, 0x01}},
+		{2097152, []byte{0x80, 0x80, 0x80, 0x01}},
+	}
+	for _, tt := range tests {
+		body := bytes.Repeat([]byte{'x'}, tt.length)
+		packet := appendPacket(nil, packetPublish<<4, body)
+		if got := packet[1 : 1+len(tt.want)]; !bytes.Equal(got, tt.want) {
+			t.Errorf("length %d encoded as % x, want % x", tt.length, got, tt.want)
+		}
+		kind, decoded, err := readPacket(bufio.NewReader(bytes.NewReader(packet)))
+		if err != nil || kind != packetPublish || len(decoded) != tt.length {
+			t.Errorf("length %d decoded as type %d, %d bytes, %v", tt.length, kind, len(decoded), err)
+		}
+	}
+
+	malformed := []byte{packetPublish << 4, 0x80, 0x80, 0x80, 0x80, 0x01}
+	if _, _, err := readPacket(bufio.NewReader(bytes.NewReader(malformed))); err == nil {
+		t.Error("five length bytes decoded without error")
+	}
+}
+
+func TestKeepAlive(t *testing.T) {
+	broker := newFakeBroker(t)
+	client := NewClient(Config{Broker: broker.ln.Addr().String(), ClientID: "nepse", KeepAlive: 20 * time.Millisecond})
+	defer client.Close()
+
+	done := make(chan error, 1)
+	go func() { done <- client.Connect(context.Background()) }()
+	_, r, _ := broker.accept(0)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if kind, _ := broker.read(r); kind != packetPingReq {
+		t.Fatalf("packet type %d, want PINGREQ", kind)
+	}
+}
+
+func TestPublishStalledBroker(t *testing.T) {
+	broker := newFakeBroker(t)
+	client := NewClient(Config{Broker: broker.ln.Addr().String(), ClientID: "nepse"})
+	defer client.Close()
+
+	done := make(chan error, 1)
+	go func() { done <- client.Connect(context.Background()) }()
+	broker.accept(0) // and never read again
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	// Far more than the socket buffers hold, so the write blocks
+	msg := Message{Topic: "nepse/floorsheet", Payload: make([]byte, 64<<20)}
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := client.Publish(ctx, msg); err == nil {
+		t.Fatal("Publish to a stalled broker succeeded")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("Publish returned after %v, want about the ctx deadline", elapsed)
+	}
+}
+
+func TestBrokerAddress(t *testing.T) {
+	tests := []struct {
+		broker  string
+		address string
+		tls     bool
+	}{
+		{"localhost", "localhost:1883", false},
+		{"localhost:1884", "localhost:1884", false},
+		{"tcp://broker:1883", "broker:1883", false},
+		{"mqtts://broker", "broker:8883", true},
+		{"ssl://broker:8884/", "broker:8884", true},
+	}
+	for _, tt := range tests {
+		address, useTLS, err := brokerAddress(tt.broker)
+		if err != nil || address != tt.address || useTLS != tt.tls {
+			t.Errorf("brokerAddress(%q) = %q, %v, %v, want %q, %v", tt.broker, address, useTLS, err, tt.address, tt.tls)
+		}
+	}
+	if _, _, err := brokerAddress("ws://broker"); err == nil {
+		t.Error("brokerAddress accepted the ws scheme")
+	}
+}
@@ -0,0 +1,142 @@
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/voidarchive/nepseauth/events"
+	"github.com/voidarchive/nepseauth/nepse"
+)
+
+// DefaultTopicPrefix is the topic prefix of a Sink with none set
+const DefaultTopicPrefix = "nepse"
+
+// SinkOptions configures a Sink
+type SinkOptions struct {
+	// Prefix is prepended to every topic (DefaultTopicPrefix if empty)
+	Prefix string
+
+	// QoS and Retain apply to every published message. Retained messages let
+	// a dashboard show the last quote of a symbol as soon as it subscribes.
+	QoS    byte
+	Retain bool
+
+	// Symbols, if set, limits quote, VWAP and alert messages to these symbols
+	Symbols []string
+
+	// Buffer is the number of events buffered while publishing lags (256 if
+	// zero); events beyond it are dropped and counted by the bus
+	Buffer int
+
+	// OnError, if set, receives the errors of failed publishes. Publishing
+	// continues with the next event.
+	OnError func(error)
+}
+
+// Sink publishes the events of a bus to MQTT topics, one per event kind and
+// symbol, with JSON payloads:
+//
+//	<prefix>/quote/<SYMBOL>          nepse.Quote of each PriceTick
+//	<prefix>/vwap/<SYMBOL>           events.VWAPTick
+//	<prefix>/index/<name>            nepse.NepseIndex of each IndexTick, name
+//	                                 being nepse, sensitive, float or sensitive-float
+//	<prefix>/alert/circuit/<SYMBOL>  events.CircuitBreakerHit
+//	<prefix>/market/status           nepse.MarketStatus on MarketOpened and MarketClosed
+type Sink struct {
+	publisher Publisher
+	opts      SinkOptions
+	symbols   map[string]bool
+}
+
+// NewSink returns a sink publishing through publisher
+func NewSink(publisher Publisher, opts SinkOptions) *Sink {
+	if opts.Prefix == "" {
+		opts.Prefix = DefaultTopicPrefix
+	}
+	opts.Prefix = strings.TrimSuffix(opts.Prefix, "/")
+	if opts.Buffer <= 0 {
+		opts.Buffer = 256
+	}
+	var symbols map[string]bool
+	if len(opts.Symbols) > 0 {
+		symbols = make(map[string]bool, len(opts.Symbols))
+		for _, symbol := range opts.Symbols {
+			symbols[nepse.NormalizeSymbol(symbol)] = true
+		}
+	}
+	return &Sink{publisher: publisher, opts: opts, symbols: symbols}
+}
+
+// Run publishes the events of bus until ctx is done or the bus is closed, and
+// returns ctx's error
+func (s *Sink) Run(ctx context.Context, bus *events.Bus) error {
+	all, cancel := events.SubscribeAll(bus, s.opts.Buffer)
+	defer cancel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case e, ok := <-all:
+			if !ok {
+				return ctx.Err()
+			}
+			if err := s.Publish(ctx, e); err != nil && ctx.Err() == nil && s.opts.OnError != nil {
+				s.opts.OnError(err)
+			}
+		}
+	}
+}
+
+// Publish publishes one event. Events of symbols filtered out and of kinds the
+// sink doesn't publish are skipped.
+func (s *Sink) Publish(ctx context.Context, e events.Event) error {
+	topic, payload, ok := s.message(e)
+	if !ok {
+		return nil
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("mqtt: failed to encode %T: %w", e, err)
+	}
+	return s.publisher.Publish(ctx, Message{
+		Topic:   s.opts.Prefix + "/" + topic,
+		Payload: body,
+		QoS:     s.opts.QoS,
+		Retain:  s.opts.Retain,
+	})
+}
+
+// message returns the topic, under the prefix, and payload of an event
+func (s *Sink) message(e events.Event) (string, any, bool) {
+	switch e := e.(type) {
+	case events.PriceTick:
+		return "quote/" + e.Quote.Symbol, e.Quote, s.wants(e.Quote.Symbol)
+	case events.VWAPTick:
+		return "vwap/" + e.Symbol, e, s.wants(e.Symbol)
+	case events.CircuitBreakerHit:
+		return "alert/circuit/" + e.Quote.Symbol, e, s.wants(e.Quote.Symbol)
+	case events.IndexTick:
+		return "index/" + indexTopic(e.Name), e.Index, true
+	case events.MarketOpened:
+		return "market/status", e.Status, true
+	case events.MarketClosed:
+		return "market/status", e.Status, true
+	}
+	return "", nil, false
+}
+
+// wants reports whether the sink publishes events of symbol
+func (s *Sink) wants(symbol string) bool {
+	if symbol == "" || strings.ContainsAny(symbol, "/+#") {
+		return false
+	}
+	return s.symbols == nil || s.symbols[nepse.NormalizeSymbol(symbol)]
+}
+
+// indexTopic turns an index name into a topic level, e.g. "Sensitive Float"
+// into "sensitive-float"
+func indexTopic(name string) string {
+	return strings.ReplaceAll(strings.ToLower(name), " ", "-")
+}
@@ -0,0 +1,245 @@
+package nepse
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+)
+
+// BackpressurePolicy decides what a subscription does when its consumer falls
+// behind and the channel buffer is full
+type BackpressurePolicy int
+
+const (
+	// BackpressureBlock makes the poller wait for the consumer, delaying the
+	// next poll. Nothing is lost. This is the default.
+	BackpressureBlock BackpressurePolicy = iota
+
+	// BackpressureDropOldest discards the oldest buffered update to make room.
+	// The poller never waits; delta streams (depth diffs, price diffs, floor
+	// sheet batches) lose the dropped changes.
+	BackpressureDropOldest
+
+	// BackpressureCoalesce folds buffered updates into the new one: snapshot
+	// streams keep only the latest, delta streams merge their changes, so the
+	// consumer catches up in one step without losing anything.
+	BackpressureCoalesce
+)
+
+// String returns the policy name
+func (p BackpressurePolicy) String() string {
+	switch p {
+	case BackpressureBlock:
+		return "block"
+	case BackpressureDropOldest:
+		return "drop-oldest"
+	case BackpressureCoalesce:
+		return "coalesce"
+	default:
+		return "unknown"
+	}
+}
+
+// outbox delivers subscription updates on a channel under the client's
+// backpressure policy. merge combines an older pending update with a newer one
+// for BackpressureCoalesce; nil keeps only the newer.
+type outbox[T any] struct {
+	ch     chan T
+	policy BackpressurePolicy
+	merge  func(older, newer T) T
+}
+
+// backpressurer is implemented by clients that carry a backpressure setting
+type backpressurer interface {
+	backpressure() (BackpressurePolicy, int)
+}
+
+// backpressure returns the client's policy and subscription buffer size
+func (h *HTTPClient) backpressure() (BackpressurePolicy, int) {
+	return h.options.Backpressure, h.options.SubscriptionBuffer
+}
+
+// newOutbox returns an outbox with the policy and buffer size of client, or a
+// blocking one-slot outbox if client has no backpressure setting
+func newOutbox[T any](client any, merge func(older, newer T) T) *outbox[T] {
+	policy, buffer := BackpressureBlock, 1
+	if b, ok := client.(backpressurer); ok {
+		policy, buffer = b.backpressure()
+	}
+	return &outbox[T]{
+		ch:     make(chan T, max(buffer, 1)),
+		policy: policy,
+		merge:  merge,
+	}
+}
+
+// send delivers v, reporting false only if ctx was done first
+func (o *outbox[T]) send(ctx context.Context, v T) bool {
+	switch o.policy {
+	case BackpressureDropOldest:
+		for {
+			select {
+			case o.ch <- v:
+				return true
+			default:
+			}
+			select {
+			case <-o.ch:
+			case <-ctx.Done():
+				return false
+			default:
+			}
+		}
+	case BackpressureCoalesce:
+		for {
+			select {
+			case o.ch <- v:
+				return true
+			default:
+			}
+			// Fold every pending update, oldest first, so order is kept
+			var pending []T
+			for drained := false; !drained; {
+				select {
+				case older := <-o.ch:
+					pending = append(pending, older)
+				default:
+					drained = true
+				}
+			}
+			if len(pending) > 0 && o.merge != nil {
+				merged := pending[0]
+				for _, p := range pending[1:] {
+					merged = o.merge(merged, p)
+				}
+				v = o.merge(merged, v)
+			}
+			if ctx.Err() != nil {
+				return false
+			}
+		}
+	default:
+		return sendUpdate(ctx, o.ch, v)
+	}
+}
+
+// close closes the channel; the outbox must not be used afterwards
+func (o *outbox[T]) close() {
+	close(o.ch)
+}
+
+// mergeTodayPrices combines two consecutive price table diffs
+func mergeTodayPrices(older, newer TodayPricesUpdate) TodayPricesUpdate {
+	changed := make(map[string]TodayPrice)
+	var order []string
+	removed := make(map[string]TodayPrice)
+	apply := func(d TodayPricesDiff) {
+		for _, p := range d.Changed {
+			key := todayPriceKey(&p)
+			if _, ok := changed[key]; !ok {
+				order = append(order, key)
+			}
+			changed[key] = p
+			delete(removed, key)
+		}
+		for _, p := range d.Removed {
+			key := todayPriceKey(&p)
+			delete(changed, key)
+			removed[key] = p
+		}
+	}
+	apply(older.TodayPricesDiff)
+	apply(newer.TodayPricesDiff)
+
+	merged := TodayPricesUpdate{Time: newer.Time, Err: newer.Err}
+	for _, key := range order {
+		if p, ok := changed[key]; ok {
+			merged.Changed = append(merged.Changed, p)
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(removed)) {
+		merged.Removed = append(merged.Removed, removed[key])
+	}
+	return merged
+}
+
+// mergeDepthDiffs combines two consecutive depth diffs into the diff from the
+// older one's starting book to the newer one's resulting book
+func mergeDepthDiffs(older, newer DepthDiff) DepthDiff {
+	type levelKey struct {
+		side  DepthSide
+		price float64
+	}
+	merged := make(map[levelKey]DepthLevelChange)
+	for _, c := range older.Changes {
+		merged[levelKey{c.Side, c.Price}] = c
+	}
+	for _, c := range newer.Changes {
+		key := levelKey{c.Side, c.Price}
+		first, ok := merged[key]
+		if !ok {
+			merged[key] = c
+			continue
+		}
+		// The level's state before older and after newer
+		existedBefore := first.Kind != DepthLevelAdded
+		existsAfter := c.Kind != DepthLevelRemoved
+		combined := DepthLevelChange{
+			Side:             c.Side,
+			Price:            c.Price,
+			Quantity:         c.Quantity,
+			Orders:           c.Orders,
+			PreviousQuantity: first.PreviousQuantity,
+			PreviousOrders:   first.PreviousOrders,
+		}
+		switch {
+		case !existedBefore && !existsAfter:
+			delete(merged, key)
+			continue
+		case !existedBefore:
+			combined.Kind = DepthLevelAdded
+		case !existsAfter:
+			combined.Kind = DepthLevelRemoved
+		case combined.Quantity == combined.PreviousQuantity && combined.Orders == combined.PreviousOrders:
+			delete(merged, key)
+			continue
+		default:
+			combined.Kind = DepthLevelChanged
+		}
+		merged[key] = combined
+	}
+
+	changes := slices.Collect(maps.Values(merged))
+	slices.SortFunc(changes, func(a, b DepthLevelChange) int {
+		if a.Side != b.Side {
+			if a.Side == DepthSideBuy {
+				return -1
+			}
+			return 1
+		}
+		if a.Side == DepthSideBuy {
+			return cmp.Compare(b.Price, a.Price)
+		}
+		return cmp.Compare(a.Price, b.Price)
+	})
+	newer.Changes = changes
+	return newer
+}
+
+// mergeFloorSheetTrades concatenates two consecutive floor sheet batches
+func mergeFloorSheetTrades(older, newer FloorSheetTrades) FloorSheetTrades {
+	newer.Trades = append(slices.Clip(older.Trades), newer.Trades...)
+	newer.LastContractID = max(older.LastContractID, newer.LastContractID)
+	return newer
+}
+
+// mergeWatchlistUpdates combines the changed quotes of two refreshes
+func mergeWatchlistUpdates(older, newer WatchlistUpdate) WatchlistUpdate {
+	if len(older.Changed) > 0 {
+		changed := maps.Clone(older.Changed)
+		maps.Copy(changed, newer.Changed)
+		newer.Changed = changed
+	}
+	return newer
+}
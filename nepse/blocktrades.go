@@ -0,0 +1,51 @@
+package nepse
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Block Trade Methods
+
+// GetBlockTrades retrieves block (large negotiated) trades for a business date
+// (YYYY-MM-DD). An empty businessDate returns the latest session.
+func (h *HTTPClient) GetBlockTrades(ctx context.Context, businessDate string) ([]BlockTrade, error) {
+	businessDate, err := h.requestDate(businessDate)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	if businessDate != "" {
+		params.Set("businessDate", businessDate)
+	}
+	params.Set("size", "500")
+
+	trades, err := fetchAllPages[BlockTrade](ctx, h, withQuery(h.endpoint(EndpointBlockTrades), params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block trades: %w", err)
+	}
+	return trades, nil
+}
+
+// GetBlockTradesBySymbol retrieves block trades of a single security for a business date
+func (h *HTTPClient) GetBlockTradesBySymbol(ctx context.Context, symbol string, businessDate string) ([]BlockTrade, error) {
+	security, err := h.findSecurityBySymbol(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find security %s: %w", symbol, err)
+	}
+
+	trades, err := h.GetBlockTrades(ctx, businessDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []BlockTrade
+	for _, t := range trades {
+		if t.SecurityID == security.ID {
+			out = append(out, t)
+		}
+	}
+	return out, nil
+}
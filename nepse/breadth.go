@@ -0,0 +1,127 @@
+package nepse
+
+import (
+	"context"
+	"time"
+)
+
+// MarketBreadth summarises how many securities moved up or down in a session
+// and how much volume went with each side. Only securities that traded and
+// have a previous close are counted.
+type MarketBreadth struct {
+	BusinessDate Timestamp `json:"businessDate"`
+
+	Advances  int `json:"advances"`
+	Declines  int `json:"declines"`
+	Unchanged int `json:"unchanged"`
+
+	UpVolume        int64 `json:"upVolume"`
+	DownVolume      int64 `json:"downVolume"`
+	UnchangedVolume int64 `json:"unchangedVolume"`
+
+	// NewHighs and NewLows count securities whose session high or low reached
+	// their 52-week high or low
+	NewHighs int `json:"newHighs"`
+	NewLows  int `json:"newLows"`
+}
+
+// Traded returns how many securities were counted
+func (b *MarketBreadth) Traded() int {
+	return b.Advances + b.Declines + b.Unchanged
+}
+
+// AdvanceDeclineRatio returns advances per decline; ok is false if nothing declined
+func (b *MarketBreadth) AdvanceDeclineRatio() (ratio float64, ok bool) {
+	if b.Declines == 0 {
+		return 0, false
+	}
+	return float64(b.Advances) / float64(b.Declines), true
+}
+
+// ComputeMarketBreadth computes the breadth of a session from its price table
+func ComputeMarketBreadth(prices []TodayPrice) MarketBreadth {
+	var breadth MarketBreadth
+	for i := range prices {
+		p := &prices[i]
+		if breadth.BusinessDate.IsZero() {
+			breadth.BusinessDate = p.BusinessDate
+		}
+		if p.TotalTradedQuantity == 0 || p.PreviousClose == 0 {
+			continue
+		}
+		switch {
+		case p.DifferenceRs > 0:
+			breadth.Advances++
+			breadth.UpVolume += p.TotalTradedQuantity
+		case p.DifferenceRs < 0:
+			breadth.Declines++
+			breadth.DownVolume += p.TotalTradedQuantity
+		default:
+			breadth.Unchanged++
+			breadth.UnchangedVolume += p.TotalTradedQuantity
+		}
+		if p.FiftyTwoWeekHigh > 0 && p.HighPrice >= p.FiftyTwoWeekHigh {
+			breadth.NewHighs++
+		}
+		if p.FiftyTwoWeekLow > 0 && p.LowPrice > 0 && p.LowPrice <= p.FiftyTwoWeekLow {
+			breadth.NewLows++
+		}
+	}
+	return breadth
+}
+
+// GetMarketBreadth returns advances, declines, up/down volume and new 52-week
+// highs and lows of the latest session, computed from today's prices
+func (h *HTTPClient) GetMarketBreadth(ctx context.Context) (*MarketBreadth, error) {
+	prices, err := h.GetTodaysPrices(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	breadth := ComputeMarketBreadth(prices)
+	return &breadth, nil
+}
+
+// MarketBreadthUpdate is one poll of market breadth, or the error it failed with
+type MarketBreadthUpdate struct {
+	MarketBreadth
+	Time time.Time
+	Err  error
+}
+
+// SubscribeMarketBreadth polls today's prices every interval and sends the
+// market breadth whenever it changes. Errors are sent as updates and retried
+// with backoff.
+func (h *HTTPClient) SubscribeMarketBreadth(ctx context.Context, interval time.Duration) (<-chan MarketBreadthUpdate, error) {
+	if err := validateInterval(interval); err != nil {
+		return nil, err
+	}
+
+	updates := newOutbox[MarketBreadthUpdate](h, nil)
+	go func() {
+		defer updates.close()
+		var last MarketBreadth
+		sent := false
+		feed := Feed{Kind: FeedTodayPrices, Interval: interval}
+		fetch := func(ctx context.Context) ([]TodayPrice, error) {
+			return h.GetTodaysPrices(ctx, "")
+		}
+		streamFeed(ctx, h.streamTransport(), feed, fetch, func(ctx context.Context, prices []TodayPrice, err error) error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err != nil {
+				updates.send(ctx, MarketBreadthUpdate{Time: time.Now(), Err: err})
+				return err
+			}
+			breadth := ComputeMarketBreadth(prices)
+			if sent && breadth == last {
+				return nil
+			}
+			if updates.send(ctx, MarketBreadthUpdate{MarketBreadth: breadth, Time: time.Now()}) {
+				last, sent = breadth, true
+			}
+			return nil
+		})
+	}()
+	return updates.ch, nil
+}
@@ -0,0 +1,67 @@
+package nepse
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// brokerFilter is the search payload expected by the member endpoint.
+// Empty fields match every broker.
+type brokerFilter struct {
+	MemberName     string `json:"memberName"`
+	ContactPerson  string `json:"contactPerson"`
+	ContactNumber  string `json:"contactNumber"`
+	MemberCode     string `json:"memberCode"`
+	ProvinceID     int32  `json:"provinceId"`
+	DistrictID     int32  `json:"districtId"`
+	MunicipalityID int32  `json:"municipalityId"`
+}
+
+// GetBrokers retrieves the list of all NEPSE member brokers
+func (h *HTTPClient) GetBrokers(ctx context.Context) ([]Broker, error) {
+	return h.searchBrokers(ctx, brokerFilter{})
+}
+
+// GetBrokerByNumber retrieves a single broker by its member number,
+// as referenced by BuyerMemberID/SellerMemberID in floor sheet entries
+func (h *HTTPClient) GetBrokerByNumber(ctx context.Context, number int32) (*Broker, error) {
+	if err := validateID("broker number", number); err != nil {
+		return nil, err
+	}
+
+	code := strconv.Itoa(int(number))
+	brokers, err := h.searchBrokers(ctx, brokerFilter{MemberCode: code})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get broker %d: %w", number, err)
+	}
+
+	// The server-side filter may match by prefix, so confirm the exact code
+	for _, broker := range brokers {
+		if strings.TrimLeft(strings.TrimSpace(broker.MemberCode), "0") == code {
+			return &broker, nil
+		}
+	}
+
+	return nil, NewNotFoundError(fmt.Sprintf("broker with number %d", number))
+}
+
+// searchBrokers walks every page of the member endpoint for the given filter
+func (h *HTTPClient) searchBrokers(ctx context.Context, filter brokerFilter) ([]Broker, error) {
+	var brokers []Broker
+	for page := int32(0); ; page++ {
+		endpoint := fmt.Sprintf("%s?page=%d&size=500", h.endpoint(EndpointBrokers), page)
+
+		var response PaginatedResponse[Broker]
+		if err := h.apiPostRequest(ctx, endpoint, filter, &response); err != nil {
+			return nil, fmt.Errorf("failed to get brokers page %d: %w", page, err)
+		}
+		brokers = append(brokers, response.Content...)
+
+		if response.Last || page+1 >= response.TotalPages {
+			break
+		}
+	}
+	return brokers, nil
+}
@@ -0,0 +1,290 @@
+package nepse
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Bikram Sambat (BS) is the official calendar of Nepal. Its months begin on the
+// day the sun enters a sidereal zodiac sign (sankranti), so month lengths vary
+// between 29 and 32 days and are not a fixed table. They are computed here with
+// the Surya Siddhanta solar model used by Nepali panchangs; published calendars
+// may differ by a day in the rare years where a sankranti falls within minutes
+// of midnight.
+
+// BS years supported by the converter (1943-04-14 AD to 2044-04-13 AD)
+const (
+	MinBSYear = 2000
+	MaxBSYear = 2100
+)
+
+// bsMonthNames are the twelve Bikram Sambat months, starting with Baisakh
+var bsMonthNames = [12]string{
+	"Baisakh", "Jestha", "Asar", "Shrawan", "Bhadra", "Asoj",
+	"Kartik", "Mangsir", "Poush", "Magh", "Falgun", "Chaitra",
+}
+
+// BSDate is a date in the Bikram Sambat calendar. Month is 1 (Baisakh) to 12 (Chaitra).
+type BSDate struct {
+	Year  int
+	Month int
+	Day   int
+}
+
+// NewBSDate validates and returns a Bikram Sambat date
+func NewBSDate(year, month, day int) (BSDate, error) {
+	d := BSDate{Year: year, Month: month, Day: day}
+	days, err := DaysInBSMonth(year, month)
+	if err != nil {
+		return BSDate{}, err
+	}
+	if day < 1 || day > days {
+		return BSDate{}, NewInvalidClientRequestError(fmt.Sprintf("invalid BS date %s: %s %d has %d days", d, d.MonthName(), year, days))
+	}
+	return d, nil
+}
+
+// ParseBSDate parses a Bikram Sambat date written as YYYY-MM-DD (e.g. "2081-04-01")
+func ParseBSDate(s string) (BSDate, error) {
+	parts := strings.Split(strings.TrimSpace(s), "-")
+	if len(parts) != 3 || len(parts[0]) != 4 {
+		return BSDate{}, NewInvalidClientRequestError(fmt.Sprintf("invalid BS date %q, expected YYYY-MM-DD", s))
+	}
+	var fields [3]int
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || !isDigits(part) {
+			return BSDate{}, NewInvalidClientRequestError(fmt.Sprintf("invalid BS date %q, expected YYYY-MM-DD", s))
+		}
+		fields[i] = n
+	}
+	return NewBSDate(fields[0], fields[1], fields[2])
+}
+
+// ToBS converts a time to the Bikram Sambat date it falls on in Nepal
+func ToBS(t time.Time) (BSDate, error) {
+	y, m, d := t.In(NepalLocation).Date()
+	day := daysSinceBSReference(y, m, d)
+
+	// 1 Baisakh always falls on 13-15 April, so the BS year is AD+57 or AD+56
+	year := y + 57
+	if year >= MinBSYear && year <= MaxBSYear+1 && day < bsMonthStarts(year)[0] {
+		year--
+	}
+	if year < MinBSYear || year > MaxBSYear {
+		return BSDate{}, NewInvalidClientRequestError(fmt.Sprintf("date %s is outside the supported BS years %d-%d", t.Format(DateFormat), MinBSYear, MaxBSYear))
+	}
+
+	starts := bsMonthStarts(year)
+	month := 12
+	for month > 1 && day < starts[month-1] {
+		month--
+	}
+	return BSDate{Year: year, Month: month, Day: day - starts[month-1] + 1}, nil
+}
+
+// ToAD returns midnight, Nepal time, of the Gregorian day matching the BS date
+func (d BSDate) ToAD() (time.Time, error) {
+	if _, err := NewBSDate(d.Year, d.Month, d.Day); err != nil {
+		return time.Time{}, err
+	}
+	day := bsMonthStarts(d.Year)[d.Month-1] + d.Day - 1
+	return bsReference.AddDate(0, 0, day), nil
+}
+
+// String formats the date as YYYY-MM-DD
+func (d BSDate) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// MonthName returns the month's name, e.g. "Shrawan"
+func (d BSDate) MonthName() string {
+	if d.Month < 1 || d.Month > 12 {
+		return strconv.Itoa(d.Month)
+	}
+	return bsMonthNames[d.Month-1]
+}
+
+// FiscalYear returns the BS year in which Nepal's fiscal year containing the date
+// began. Fiscal years run from 1 Shrawan to the end of Asar, e.g. 2081/82.
+func (d BSDate) FiscalYear() int {
+	if d.Month < 4 {
+		return d.Year - 1
+	}
+	return d.Year
+}
+
+// DaysInBSMonth returns the number of days in a Bikram Sambat month
+func DaysInBSMonth(year, month int) (int, error) {
+	if year < MinBSYear || year > MaxBSYear {
+		return 0, NewInvalidClientRequestError(fmt.Sprintf("BS year %d is outside the supported range %d-%d", year, MinBSYear, MaxBSYear))
+	}
+	if month < 1 || month > 12 {
+		return 0, NewInvalidClientRequestError(fmt.Sprintf("invalid BS month %d", month))
+	}
+	starts := bsMonthStarts(year)
+	return starts[month] - starts[month-1], nil
+}
+
+// BSToAD converts a BS date string (YYYY-MM-DD) to an AD date string (YYYY-MM-DD)
+func BSToAD(date string) (string, error) {
+	bs, err := ParseBSDate(date)
+	if err != nil {
+		return "", err
+	}
+	ad, err := bs.ToAD()
+	if err != nil {
+		return "", err
+	}
+	return ad.Format(DateFormat), nil
+}
+
+// ADToBS converts an AD date string (YYYY-MM-DD) to a BS date string (YYYY-MM-DD)
+func ADToBS(date string) (string, error) {
+	ad, err := time.ParseInLocation(DateFormat, date, NepalLocation)
+	if err != nil {
+		return "", NewInvalidClientRequestError(fmt.Sprintf("invalid date %q, expected YYYY-MM-DD", date))
+	}
+	bs, err := ToBS(ad)
+	if err != nil {
+		return "", err
+	}
+	return bs.String(), nil
+}
+
+// requestDate converts and validates a caller-supplied businessDate parameter,
+// returning the AD date NEPSE expects. Dates are read as BS only when the client
+// was created with BSDates.
+func (h *HTTPClient) requestDate(date string) (string, error) {
+	return h.convertDateArg("businessDate", date)
+}
+
+// requestDateRange converts a start and end date parameter like requestDate, and
+// checks that the range does not end before it starts
+func (h *HTTPClient) requestDateRange(startDate, endDate string) (string, string, error) {
+	start, err := h.convertDateArg("startDate", startDate)
+	if err != nil {
+		return "", "", err
+	}
+	end, err := h.convertDateArg("endDate", endDate)
+	if err != nil {
+		return "", "", err
+	}
+	if err := validateDateRange(start, end); err != nil {
+		return "", "", err
+	}
+	return start, end, nil
+}
+
+// convertDateArg converts the date parameter param to a validated AD date
+func (h *HTTPClient) convertDateArg(param, date string) (string, error) {
+	if date == "" {
+		return "", nil
+	}
+	if h.options.BSDates {
+		ad, err := BSToAD(date)
+		if err != nil {
+			return "", NewNepseError(ErrorTypeInvalidArgument, fmt.Sprintf("invalid %s %q", param, date), err)
+		}
+		return ad, nil
+	}
+	if _, err := parseDateArg(param, date); err != nil {
+		return "", err
+	}
+	return date, nil
+}
+
+// formatDate formats a time as a date parameter in the calendar the client's
+// callers use, so internally built dates round-trip through requestDate
+func (h *HTTPClient) formatDate(t time.Time) string {
+	if h.options.BSDates {
+		if bs, err := ToBS(t); err == nil {
+			return bs.String()
+		}
+	}
+	return t.In(NepalLocation).Format(DateFormat)
+}
+
+// Surya Siddhanta constants
+const (
+	// siderealYearDays is the length of the sidereal year: 1,577,917,828 civil
+	// days in a mahayuga of 4,320,000 years
+	siderealYearDays = 1577917828.0 / 4320000
+	// kaliEpochJD is the Julian date of the start of the Kali Yuga (Ujjain midnight)
+	kaliEpochJD = 588465.5
+	// referenceJD is the Julian date of bsReference (2000-01-01)
+	referenceJD = 2451544.5
+	// sunApogeeDeg is the longitude of the sun's apogee
+	sunApogeeDeg = 77 + 17.0/60
+	// ujjainToNepalDays converts the model's Ujjain-based day count to Nepal Standard Time
+	ujjainToNepalDays = 38.2 / (24 * 60)
+)
+
+// bsReference is the day from which month starts are counted
+var bsReference = time.Date(2000, time.January, 1, 0, 0, 0, 0, NepalLocation)
+
+// bsYearCache memoises the month starts of each BS year
+var bsYearCache sync.Map
+
+// bsMonthStarts returns, for a BS year, the first day of each of its twelve months
+// and of the following Baisakh, as days since bsReference
+func bsMonthStarts(year int) [13]int {
+	if cached, ok := bsYearCache.Load(year); ok {
+		return cached.([13]int)
+	}
+
+	var starts [13]int
+	baisakh := float64(daysSinceBSReference(year-57, time.April, 14))
+	for m := 0; m <= 12; m++ {
+		sankranti := sankrantiAround(baisakh+30.44*float64(m), float64(30*(m%12)))
+		starts[m] = int(math.Floor(sankranti + ujjainToNepalDays))
+	}
+	bsYearCache.Store(year, starts)
+	return starts
+}
+
+// sankrantiAround finds the moment, in days since bsReference (Ujjain time), near
+// guess when the sun's true sidereal longitude reaches target degrees
+func sankrantiAround(guess, target float64) float64 {
+	offset := referenceJD - kaliEpochJD
+	lo, hi := offset+guess-20, offset+guess+20
+	for i := 0; i < 64; i++ {
+		mid := (lo + hi) / 2
+		if positiveMod(sunLongitude(mid)-target+180, 360)-180 < 0 {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo+hi)/2 - offset
+}
+
+// sunLongitude returns the sun's true sidereal longitude in degrees, ahargana days
+// after the Kali epoch, applying the Surya Siddhanta equation of centre
+func sunLongitude(ahargana float64) float64 {
+	mean := positiveMod(360*ahargana/siderealYearDays, 360)
+	anomaly := (mean - sunApogeeDeg) * math.Pi / 180
+	epicycle := 14 - math.Abs(math.Sin(anomaly))/3
+	equation := math.Asin(epicycle/360*math.Sin(anomaly)) * 180 / math.Pi
+	return positiveMod(mean-equation, 360)
+}
+
+// daysSinceBSReference counts days from bsReference to a Gregorian date
+func daysSinceBSReference(year int, month time.Month, day int) int {
+	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	ref := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return int(t.Sub(ref).Hours() / 24)
+}
+
+// positiveMod returns x modulo m in [0, m)
+func positiveMod(x, m float64) float64 {
+	r := math.Mod(x, m)
+	if r < 0 {
+		r += m
+	}
+	return r
+}
@@ -0,0 +1,216 @@
+package nepse
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// NepalLocation is Nepal Standard Time (UTC+05:45), the timezone NEPSE trades in.
+// Nepal does not observe daylight saving, so a fixed zone is exact.
+var NepalLocation = time.FixedZone("Asia/Kathmandu", 5*60*60+45*60)
+
+// Holiday represents a NEPSE market holiday
+type Holiday struct {
+	Date        string `json:"holidayDate"`
+	Description string `json:"description"`
+}
+
+// TradingCalendar answers trading-day questions for a year of NEPSE holidays.
+// NEPSE trades Sunday to Thursday, except on published holidays.
+type TradingCalendar struct {
+	Year     int
+	Holidays []Holiday
+
+	byDate map[string]Holiday
+}
+
+// NewTradingCalendar builds a calendar from a list of holidays
+func NewTradingCalendar(year int, holidays []Holiday) *TradingCalendar {
+	byDate := make(map[string]Holiday, len(holidays))
+	for _, holiday := range holidays {
+		if len(holiday.Date) >= len(DateFormat) {
+			byDate[holiday.Date[:len(DateFormat)]] = holiday
+		}
+	}
+	return &TradingCalendar{
+		Year:     year,
+		Holidays: holidays,
+		byDate:   byDate,
+	}
+}
+
+// IsWeekend returns true on Friday and Saturday, when NEPSE never trades
+func IsWeekend(date time.Time) bool {
+	switch date.In(NepalLocation).Weekday() {
+	case time.Friday, time.Saturday:
+		return true
+	default:
+		return false
+	}
+}
+
+// Holiday returns the holiday falling on the given date, if any
+func (c *TradingCalendar) Holiday(date time.Time) (Holiday, bool) {
+	holiday, ok := c.byDate[date.In(NepalLocation).Format(DateFormat)]
+	return holiday, ok
+}
+
+// IsTradingDay returns true if NEPSE trades on the given date
+func (c *TradingCalendar) IsTradingDay(date time.Time) bool {
+	if IsWeekend(date) {
+		return false
+	}
+	_, holiday := c.Holiday(date)
+	return !holiday
+}
+
+// PreviousTradingDay returns the most recent trading day on or before date
+// that falls within the calendar year
+func (c *TradingCalendar) PreviousTradingDay(date time.Time) (time.Time, bool) {
+	d := date.In(NepalLocation)
+	for d.Year() == c.Year {
+		if c.IsTradingDay(d) {
+			return d, true
+		}
+		d = d.AddDate(0, 0, -1)
+	}
+	return time.Time{}, false
+}
+
+// calendarRetryDelay is how long a failed holiday list fetch is remembered before
+// it is tried again
+const calendarRetryDelay = 15 * time.Minute
+
+// calendarCache keeps trading calendars per year so holiday lists are fetched once
+type calendarCache struct {
+	mu        sync.Mutex
+	calendars map[int]*TradingCalendar
+	// failures holds the last failed fetch per year until it is retried
+	failures map[int]calendarFailure
+}
+
+// calendarFailure is a failed holiday list fetch
+type calendarFailure struct {
+	err   error
+	until time.Time
+}
+
+// GetTradingCalendar retrieves the NEPSE holiday list for a (Gregorian) year. A
+// failed fetch is returned again without a request for a while, so pollers
+// consulting the calendar don't double their requests while it is unavailable.
+func (h *HTTPClient) GetTradingCalendar(ctx context.Context, year int) (*TradingCalendar, error) {
+	h.calendars.mu.Lock()
+	calendar, ok := h.calendars.calendars[year]
+	failure, failed := h.calendars.failures[year]
+	h.calendars.mu.Unlock()
+	if ok {
+		recordCacheHit(ctx)
+		return calendar, nil
+	}
+	if failed && time.Now().Before(failure.until) {
+		return nil, failure.err
+	}
+
+	endpoint := fmt.Sprintf("%s?year=%d", h.endpoint(EndpointHolidays), year)
+
+	var holidays []Holiday
+	err := h.apiRequest(ctx, endpoint, &holidays)
+	if err != nil {
+		err = fmt.Errorf("failed to get trading calendar for %d: %w", year, err)
+		if ctx.Err() == nil {
+			h.calendars.mu.Lock()
+			if h.calendars.failures == nil {
+				h.calendars.failures = make(map[int]calendarFailure)
+			}
+			h.calendars.failures[year] = calendarFailure{err: err, until: time.Now().Add(calendarRetryDelay)}
+			h.calendars.mu.Unlock()
+		}
+		return nil, err
+	}
+
+	calendar = NewTradingCalendar(year, holidays)
+	h.calendars.mu.Lock()
+	if h.calendars.calendars == nil {
+		h.calendars.calendars = make(map[int]*TradingCalendar)
+	}
+	h.calendars.calendars[year] = calendar
+	delete(h.calendars.failures, year)
+	h.calendars.mu.Unlock()
+	return calendar, nil
+}
+
+// RefreshTradingCalendar drops cached holiday lists, and failed fetches, so they
+// are fetched again
+func (h *HTTPClient) RefreshTradingCalendar() {
+	h.calendars.mu.Lock()
+	h.calendars.calendars = nil
+	h.calendars.failures = nil
+	h.calendars.mu.Unlock()
+}
+
+// IsTradingDay returns true if NEPSE trades on the given date
+func (h *HTTPClient) IsTradingDay(ctx context.Context, date time.Time) (bool, error) {
+	calendar, err := h.GetTradingCalendar(ctx, date.In(NepalLocation).Year())
+	if err != nil {
+		return false, err
+	}
+	return calendar.IsTradingDay(date), nil
+}
+
+// LastTradingDay returns the most recent business date (midnight, Nepal time) with
+// trading data. It uses today while the market is open, then the latest day in the
+// market-summary history, and finally the holiday calendar (or the Friday/Saturday
+// weekend if the calendar is unavailable).
+func (h *HTTPClient) LastTradingDay(ctx context.Context) (time.Time, error) {
+	now := time.Now().In(NepalLocation)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, NepalLocation)
+
+	if status, err := h.GetMarketStatus(ctx); err == nil && status.IsMarketOpen() {
+		return today, nil
+	} else if err != nil {
+		h.logger.Debug("market status unavailable for last trading day", "error", err)
+	}
+
+	// Two weeks always spans a trading day, even around Dashain/Tihar closures
+	start := h.formatDate(today.AddDate(0, 0, -14))
+	if history, err := h.GetMarketSummaryHistory(ctx, start, h.formatDate(today)); err == nil {
+		var latest string
+		for _, entry := range history {
+			if date := entry.BusinessDate.Date(); date > latest {
+				latest = date
+			}
+		}
+		if latest != "" {
+			if d, err := time.ParseInLocation(DateFormat, latest, NepalLocation); err == nil {
+				return d, nil
+			}
+		}
+	} else {
+		h.logger.Debug("market summary history unavailable for last trading day", "error", err)
+	}
+
+	for _, year := range []int{today.Year(), today.Year() - 1} {
+		calendar, err := h.GetTradingCalendar(ctx, year)
+		if err != nil {
+			break
+		}
+		from := today
+		if year != today.Year() {
+			from = time.Date(year, time.December, 31, 0, 0, 0, 0, NepalLocation)
+		}
+		if d, ok := calendar.PreviousTradingDay(from); ok {
+			return d, nil
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return time.Time{}, NewNetworkError(err)
+	}
+	d := today
+	for IsWeekend(d) {
+		d = d.AddDate(0, 0, -1)
+	}
+	return d, nil
+}
@@ -2,6 +2,9 @@ package nepse
 
 import (
     "context"
+    "io"
+    "iter"
+    "log/slog"
     "net/http"
     "time"
 )
@@ -10,39 +13,81 @@ import (
 type Client interface {
 	// Market Data Methods
 	GetMarketSummary(ctx context.Context) (*MarketSummary, error)
+	GetMarketSummaryHistory(ctx context.Context, startDate, endDate string) ([]MarketSummaryHistoryEntry, error)
 	GetMarketStatus(ctx context.Context) (*MarketStatus, error)
 	GetNepseIndex(ctx context.Context) (*NepseIndex, error)
+	GetSensitiveIndex(ctx context.Context) (*NepseIndex, error)
+	GetFloatIndex(ctx context.Context) (*NepseIndex, error)
+	GetSensitiveFloatIndex(ctx context.Context) (*NepseIndex, error)
+	GetAllIndices(ctx context.Context) (*MainIndices, error)
 	GetNepseSubIndices(ctx context.Context) ([]SubIndex, error)
-	GetLiveMarket(ctx context.Context) ([]LiveMarketEntry, error)
+	GetIndexHistory(ctx context.Context, indexID int32, startDate, endDate string) ([]IndexHistoryEntry, error)
+	GetIndexOHLC(ctx context.Context, indexID int32, startDate, endDate string) ([]Candle, error)
+	GetIndexConstituents(ctx context.Context, indexID int32) ([]IndexConstituent, error)
+	GetLiveMarket(ctx context.Context, opts ...SortOption) ([]LiveMarketEntry, error)
+	GetLiveMarketFor(ctx context.Context, symbols []string) ([]LiveMarketEntry, error)
 
 	// Security and Company Methods
 	GetSecurityList(ctx context.Context) ([]Security, error)
+	GetSecurityListWithOptions(ctx context.Context, opts SecurityListOptions) ([]Security, error)
+	GetSecurityListByInstrument(ctx context.Context, instrument InstrumentType) ([]Security, error)
+	GetSecurityListFiltered(ctx context.Context, filter SecurityFilter) ([]Security, error)
+	GetDebentures(ctx context.Context) ([]Debenture, error)
+	GetSuspendedSecurities(ctx context.Context) ([]Security, error)
 	GetCompanyList(ctx context.Context) ([]Company, error)
 	GetCompanyDetails(ctx context.Context, securityID int32) (*CompanyDetails, error)
+	GetCompanyDetailsRaw(ctx context.Context, securityID int32) (*CompanyDetailsRaw, error)
 	GetCompanyDetailsBySymbol(ctx context.Context, symbol string) (*CompanyDetails, error)
+	GetCompanyProfile(ctx context.Context, securityID int32) (*CompanyProfile, error)
+	GetCompanyProfileBySymbol(ctx context.Context, symbol string) (*CompanyProfile, error)
+	GetSectors(ctx context.Context) ([]Sector, error)
 	GetSectorScrips(ctx context.Context) (SectorScrips, error)
+	GetSectorScripsWithOptions(ctx context.Context, opts SectorScripsOptions) (SectorScrips, error)
+	GetSectorSummary(ctx context.Context) ([]SectorSummary, error)
+	GetMarketBreadth(ctx context.Context) (*MarketBreadth, error)
 
 	// Price and Trading Data
-	GetTodaysPrices(ctx context.Context, businessDate string) ([]TodayPrice, error)
+	GetTodaysPrices(ctx context.Context, businessDate string, opts ...SortOption) ([]TodayPrice, error)
+	GetTodaysPricesPage(ctx context.Context, businessDate string, page, size int) (*Page[TodayPrice], error)
+	GetTodaysPricesByInstrument(ctx context.Context, businessDate string, instruments ...InstrumentType) ([]TodayPrice, error)
+	DownloadTodaysPricesCSV(ctx context.Context, businessDate string, w io.Writer) error
 	GetPriceVolumeHistory(ctx context.Context, securityID int32, startDate, endDate string) ([]PriceHistory, error)
+	GetPriceVolumeHistoryPage(ctx context.Context, securityID int32, startDate, endDate string, page, size int) (*Page[PriceHistory], error)
 	GetPriceVolumeHistoryBySymbol(ctx context.Context, symbol string, startDate, endDate string) ([]PriceHistory, error)
 	GetSupplyDemand(ctx context.Context) ([]SupplyDemandEntry, error)
+	GetSupplyDemandOf(ctx context.Context, securityID int32) (*SecuritySupplyDemand, error)
+	GetSupplyDemandBySymbol(ctx context.Context, symbol string) (*SecuritySupplyDemand, error)
+	GetPriceBand(ctx context.Context, securityID int32) (*PriceBand, error)
+	GetPriceBandBySymbol(ctx context.Context, symbol string) (*PriceBand, error)
     GetMarketDepth(ctx context.Context, securityID int32) (*MarketDepth, error)
     GetMarketDepthBySymbol(ctx context.Context, symbol string) (*MarketDepth, error)
+	GetMarketDepthAll(ctx context.Context, symbols []string) (map[string]*MarketDepth, error)
 
 	// Top Lists
-	GetTopGainers(ctx context.Context) ([]TopListEntry, error)
-	GetTopLosers(ctx context.Context) ([]TopListEntry, error)
-	GetTopTenTrade(ctx context.Context) ([]TopListEntry, error)
-	GetTopTenTransaction(ctx context.Context) ([]TopListEntry, error)
-	GetTopTenTurnover(ctx context.Context) ([]TopListEntry, error)
+	GetTopGainers(ctx context.Context, opts ...SortOption) ([]TopListEntry, error)
+	GetTopLosers(ctx context.Context, opts ...SortOption) ([]TopListEntry, error)
+	GetTopTenTrade(ctx context.Context, opts ...SortOption) ([]TopListEntry, error)
+	GetTopTenTransaction(ctx context.Context, opts ...SortOption) ([]TopListEntry, error)
+	GetTopTenTurnover(ctx context.Context, opts ...SortOption) ([]TopListEntry, error)
+	GetTopList(ctx context.Context, list TopList, limit int, opts ...SortOption) ([]TopListEntry, error)
+	GetTopMarketCap(ctx context.Context, limit int) ([]MarketCapEntry, error)
+	GetFiftyTwoWeekHighLow(ctx context.Context) (*FiftyTwoWeekHighLow, error)
 
 	// Floor Sheet
 	GetFloorSheet(ctx context.Context) ([]FloorSheetEntry, error)
+	GetFloorSheetAll(ctx context.Context, businessDate string, progress func(FloorSheetProgress)) ([]FloorSheetEntry, error)
+	GetFloorSheetPage(ctx context.Context, businessDate string, page, size int) (*Page[FloorSheetEntry], error)
+	FloorSheetAllIter(ctx context.Context, businessDate string) iter.Seq2[FloorSheetEntry, error]
+	TailFloorSheet(ctx context.Context, businessDate string, opts ...TailOption) (<-chan FloorSheetTrades, error)
 	GetFloorSheetOf(ctx context.Context, securityID int32, businessDate string) ([]FloorSheetEntry, error)
+	GetFloorSheetPageOf(ctx context.Context, securityID int32, businessDate string, page, size int) (*Page[FloorSheetEntry], error)
+	FloorSheetIter(ctx context.Context, securityID int32, businessDate string) iter.Seq2[FloorSheetEntry, error]
 	GetFloorSheetBySymbol(ctx context.Context, symbol string, businessDate string) ([]FloorSheetEntry, error)
+	GetFloorSheetByBroker(ctx context.Context, brokerNumber int32, businessDate string) ([]FloorSheetEntry, error)
+	GetBlockTrades(ctx context.Context, businessDate string) ([]BlockTrade, error)
+	GetBlockTradesBySymbol(ctx context.Context, symbol string, businessDate string) ([]BlockTrade, error)
 
-	// Graph Data (GET endpoints)
+	// Graph Data (POST endpoints with the computed payload ID)
 	GetDailyNepseIndexGraph(ctx context.Context) (*GraphResponse, error)
 	GetDailySensitiveIndexGraph(ctx context.Context) (*GraphResponse, error)
 	GetDailyFloatIndexGraph(ctx context.Context) (*GraphResponse, error)
@@ -50,6 +95,9 @@ type Client interface {
 	GetDailyScripPriceGraph(ctx context.Context, securityID int32) (*GraphResponse, error)
 	GetDailyScripPriceGraphBySymbol(ctx context.Context, symbol string) (*GraphResponse, error)
 
+	GetIndexGraph(ctx context.Context, indexID int32, opts *GraphOptions) (*GraphResponse, error)
+	GetScripPriceGraph(ctx context.Context, securityID int32, opts *GraphOptions) (*GraphResponse, error)
+
 	// Sub-Index Graphs
 	GetDailyBankSubindexGraph(ctx context.Context) (*GraphResponse, error)
 	GetDailyDevelopmentBankSubindexGraph(ctx context.Context) (*GraphResponse, error)
@@ -65,9 +113,55 @@ type Client interface {
 	GetDailyOthersSubindexGraph(ctx context.Context) (*GraphResponse, error)
 	GetDailyTradingSubindexGraph(ctx context.Context) (*GraphResponse, error)
 
+	// Brokers
+	GetBrokers(ctx context.Context) ([]Broker, error)
+	GetBrokerByNumber(ctx context.Context, number int32) (*Broker, error)
+
+	// News and Announcements
+	GetNews(ctx context.Context, page, size int) ([]NewsItem, error)
+	GetCompanyDisclosures(ctx context.Context, securityID int32) ([]CompanyDisclosure, error)
+	GetCompanyDisclosuresBySymbol(ctx context.Context, symbol string) ([]CompanyDisclosure, error)
+	GetPressReleases(ctx context.Context, page, size int) ([]Publication, error)
+	GetPressRelease(ctx context.Context, id int32) (*Publication, error)
+	GetCirculars(ctx context.Context, page, size int) ([]Publication, error)
+	GetCircular(ctx context.Context, id int32) (*Publication, error)
+	GetApplicationReports(ctx context.Context, page, size int) ([]Publication, error)
+	GetApplicationReport(ctx context.Context, id int32) (*Publication, error)
+
+	// Corporate Actions
+	GetDividendHistory(ctx context.Context, securityID int32) ([]Dividend, error)
+	GetDividendHistoryBySymbol(ctx context.Context, symbol string) ([]Dividend, error)
+	GetCorporateEvents(ctx context.Context, securityID int32) ([]CorporateEvent, error)
+	GetCorporateEventsBySymbol(ctx context.Context, symbol string) ([]CorporateEvent, error)
+	GetUpcomingIssues(ctx context.Context) ([]Issue, error)
+
+	// Trading Calendar
+	GetTradingCalendar(ctx context.Context, year int) (*TradingCalendar, error)
+	IsTradingDay(ctx context.Context, date time.Time) (bool, error)
+	LastTradingDay(ctx context.Context) (time.Time, error)
+	NextMarketOpen(ctx context.Context) (time.Time, error)
+	NextMarketClose(ctx context.Context) (time.Time, error)
+	WatchMarket(ctx context.Context, opts MarketWatchOptions) error
+
+	// Subscriptions
+	SubscribeLiveMarket(ctx context.Context, interval time.Duration) (<-chan LiveMarketUpdate, error)
+	SubscribeQuote(ctx context.Context, symbol string, interval time.Duration) (<-chan Quote, error)
+	SubscribeTodaysPrices(ctx context.Context, interval time.Duration) (<-chan TodayPricesUpdate, error)
+	SubscribeMarketDepth(ctx context.Context, securityID int32, interval time.Duration) (<-chan DepthDiff, error)
+	SubscribeMarketDepthBySymbol(ctx context.Context, symbol string, interval time.Duration) (<-chan DepthDiff, error)
+	SubscribeMarketBreadth(ctx context.Context, interval time.Duration) (<-chan MarketBreadthUpdate, error)
+	SubscribeTopMovers(ctx context.Context, interval time.Duration, opts TopMoversOptions) (<-chan TopMoversUpdate, error)
+
 	// Helper Methods
 	FindSecurity(ctx context.Context, securityID int32) (*Security, error)
 	FindSecurityBySymbol(ctx context.Context, symbol string) (*Security, error)
+	RefreshSymbols(ctx context.Context) error
+	ResolveSymbols(ctx context.Context, symbols []string) (map[string]int32, error)
+	SearchSecurities(ctx context.Context, query string) ([]Security, error)
+	GetOrdinaryShareBySymbol(ctx context.Context, symbol string) (*Security, error)
+
+	// Raw Access
+	Forward(ctx context.Context, method, path string, body []byte) ([]byte, error)
 
 	// Configuration
 	SetTLSVerification(enabled bool)
@@ -79,9 +173,15 @@ type Client interface {
 
 // Options represents configuration options for creating a new NEPSE client
 type Options struct {
-    // BaseURL overrides the default NEPSE API base URL
+    // BaseURL overrides the default NEPSE API base URL.
+    // It takes precedence over Environment and Config.BaseURL.
     BaseURL string
 
+	// Environment selects a well-known deployment (production, newweb mirror, local mock).
+	// It takes precedence over Config.BaseURL; when empty, as by default,
+	// Config.BaseURL is used, then DefaultBaseURL.
+	Environment Environment
+
 	// TLSVerification enables/disables TLS certificate verification
 	TLSVerification bool
 
@@ -100,16 +200,57 @@ type Options struct {
     // HTTPClient allows supplying a custom *http.Client.
     // If nil, a sane default client and transport are created using other options.
     HTTPClient *http.Client
+
+	// Logger receives debug output about retries and token refreshes.
+	// If nil, logging is disabled.
+	Logger *slog.Logger
+
+	// RateLimit caps outgoing requests per second (0 disables rate limiting)
+	RateLimit float64
+
+	// Proxy is an HTTP(S) proxy URL. If empty, the standard HTTP_PROXY,
+	// HTTPS_PROXY and NO_PROXY environment variables are honoured.
+	Proxy string
+
+	// BSDates makes date parameters (businessDate, startDate, endDate) read as
+	// Bikram Sambat YYYY-MM-DD and converted to AD before requests are sent
+	BSDates bool
+
+	// SchemaDriftHandler, if set, is called when a response has keys the models
+	// don't map or lacks fields they expect
+	SchemaDriftHandler SchemaDriftHandler
+
+	// SymbolCacheTTL is how long the security list behind symbol lookups (the
+	// BySymbol methods, FindSecurity, FindSecurityBySymbol) is reused. Zero
+	// disables the cache so every lookup downloads the list.
+	SymbolCacheTTL time.Duration
+
+	// Backpressure is what subscriptions do when their receiver falls behind
+	// (BackpressureBlock by default)
+	Backpressure BackpressurePolicy
+
+	// SubscriptionBuffer is how many updates a subscription channel holds
+	// (1 if zero)
+	SubscriptionBuffer int
+
+	// StreamTransport carries subscription feeds (PollingTransport if nil)
+	StreamTransport Transport
+
+	// OffHoursInterval is how often subscriptions poll outside trading hours
+	// and on holidays. Zero pauses them until shortly before the next open;
+	// negative keeps them at their own interval around the clock.
+	OffHoursInterval time.Duration
 }
 
 // DefaultOptions returns default options for the NEPSE client
 func DefaultOptions() *Options {
 	return &Options{
-		BaseURL:         "https://www.nepalstock.com",
-		TLSVerification: true,
-		HTTPTimeout:     30 * time.Second,
-		MaxRetries:      3,
-		RetryDelay:      time.Second,
-		Config:          DefaultConfig(),
+		TLSVerification:  true,
+		HTTPTimeout:      30 * time.Second,
+		MaxRetries:       3,
+		RetryDelay:       time.Second,
+		Config:           DefaultConfig(),
+		SymbolCacheTTL:   DefaultSymbolCacheTTL,
+		OffHoursInterval: DefaultOffHoursInterval,
 	}
 }
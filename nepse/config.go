@@ -1,55 +1,200 @@
 package nepse
 
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
 // Config holds static configuration data for the NEPSE API
 type Config struct {
-	BaseURL      string
-	APIEndpoints map[string]string
-	Headers      map[string]string
+	BaseURL   string
+	Endpoints Endpoints
+	Headers   map[string]string
+}
+
+// Endpoint identifies a NEPSE API endpoint in the registry
+type Endpoint string
+
+// Known NEPSE API endpoints
+const (
+	EndpointPriceVolume                   Endpoint = "price_volume"
+	EndpointMarketSummary                 Endpoint = "market_summary"
+	EndpointSupplyDemand                  Endpoint = "supply_demand"
+	EndpointTopGainers                    Endpoint = "top_gainers"
+	EndpointTopLosers                     Endpoint = "top_losers"
+	EndpointTopTenTrade                   Endpoint = "top_ten_trade"
+	EndpointTopTenTransaction             Endpoint = "top_ten_transaction"
+	EndpointTopTenTurnover                Endpoint = "top_ten_turnover"
+	EndpointMarketOpen                    Endpoint = "market_open"
+	EndpointNepseIndex                    Endpoint = "nepse_index"
+	EndpointCompanyList                   Endpoint = "company_list"
+	EndpointSecurityList                  Endpoint = "security_list"
+	EndpointNepseIndexDailyGraph          Endpoint = "nepse_index_daily_graph"
+	EndpointSensitiveIndexDailyGraph      Endpoint = "sensitive_index_daily_graph"
+	EndpointFloatIndexDailyGraph          Endpoint = "float_index_daily_graph"
+	EndpointSensitiveFloatIndexDailyGraph Endpoint = "sensitive_float_index_daily_graph"
+	EndpointBankingSubIndexGraph          Endpoint = "banking_sub_index_graph"
+	EndpointDevelopmentBankSubIndexGraph  Endpoint = "development_bank_sub_index_graph"
+	EndpointFinanceSubIndexGraph          Endpoint = "finance_sub_index_graph"
+	EndpointHotelTourismSubIndexGraph     Endpoint = "hotel_tourism_sub_index_graph"
+	EndpointHydroSubIndexGraph            Endpoint = "hydro_sub_index_graph"
+	EndpointInvestmentSubIndexGraph       Endpoint = "investment_sub_index_graph"
+	EndpointLifeInsuranceSubIndexGraph    Endpoint = "life_insurance_sub_index_graph"
+	EndpointManufacturingSubIndexGraph    Endpoint = "manufacturing_sub_index_graph"
+	EndpointMicrofinanceSubIndexGraph     Endpoint = "microfinance_sub_index_graph"
+	EndpointMutualFundSubIndexGraph       Endpoint = "mutual_fund_sub_index_graph"
+	EndpointNonLifeInsuranceSubIndexGraph Endpoint = "non_life_insurance_sub_index_graph"
+	EndpointOthersSubIndexGraph           Endpoint = "others_sub_index_graph"
+	EndpointTradingSubIndexGraph          Endpoint = "trading_sub_index_graph"
+	EndpointCompanyDailyGraph             Endpoint = "company_daily_graph"
+	EndpointCompanyDetails                Endpoint = "company_details"
+	EndpointCompanyPriceVolumeHistory     Endpoint = "company_price_volume_history"
+	EndpointCompanyFloorsheet             Endpoint = "company_floorsheet"
+	EndpointFloorSheet                    Endpoint = "floor_sheet"
+	EndpointTodaysPrice                   Endpoint = "todays_price"
+	EndpointLiveMarket                    Endpoint = "live_market"
+	EndpointMarketDepth                   Endpoint = "market_depth"
+	EndpointBrokers                       Endpoint = "brokers"
+	EndpointNews                          Endpoint = "news"
+	EndpointFetchFile                     Endpoint = "fetch_file"
+	EndpointCompanyDisclosures            Endpoint = "company_disclosures"
+	EndpointDividends                     Endpoint = "dividends"
+	EndpointCorporateEvents               Endpoint = "corporate_events"
+	EndpointUpcomingIssues                Endpoint = "upcoming_issues"
+	EndpointDebentures                    Endpoint = "debentures"
+	EndpointHolidays                      Endpoint = "holidays"
+	EndpointIndexConstituents             Endpoint = "index_constituents"
+	EndpointSectors                       Endpoint = "sectors"
+	EndpointFiftyTwoWeekHighLow           Endpoint = "fifty_two_week_high_low"
+	EndpointTopMarketCap                  Endpoint = "top_market_cap"
+	EndpointSuspendedSecurities           Endpoint = "suspended_securities"
+	EndpointTodaysPriceCSV                Endpoint = "todays_price_csv"
+	EndpointIndexHistory                  Endpoint = "index_history"
+	EndpointBlockTrades                   Endpoint = "block_trades"
+	EndpointMarketSummaryHistory          Endpoint = "market_summary_history"
+	EndpointPressReleases                 Endpoint = "press_releases"
+	EndpointCirculars                     Endpoint = "circulars"
+	EndpointApplicationReports            Endpoint = "application_reports"
+	EndpointSecuritySearch                Endpoint = "security_search"
+)
+
+// Endpoints maps each Endpoint to its URL path relative to Config.BaseURL.
+// Paths can be overridden at runtime when NEPSE moves an endpoint.
+type Endpoints map[Endpoint]string
+
+// DefaultEndpoints returns the registry of known NEPSE API endpoint paths
+func DefaultEndpoints() Endpoints {
+	return Endpoints{
+		EndpointPriceVolume:                   "/api/nots/securityDailyTradeStat/58",
+		EndpointMarketSummary:                 "/api/nots/market-summary/",
+		EndpointSupplyDemand:                  "/api/nots/nepse-data/supplydemand",
+		EndpointTopGainers:                    "/api/nots/top-ten/top-gainer",
+		EndpointTopLosers:                     "/api/nots/top-ten/top-loser",
+		EndpointTopTenTrade:                   "/api/nots/top-ten/trade",
+		EndpointTopTenTransaction:             "/api/nots/top-ten/transaction",
+		EndpointTopTenTurnover:                "/api/nots/top-ten/turnover",
+		EndpointMarketOpen:                    "/api/nots/nepse-data/market-open",
+		EndpointNepseIndex:                    "/api/nots/nepse-index",
+		EndpointCompanyList:                   "/api/nots/company/list",
+		EndpointSecurityList:                  "/api/nots/security",
+		EndpointNepseIndexDailyGraph:          "/api/nots/graph/index/58",
+		EndpointSensitiveIndexDailyGraph:      "/api/nots/graph/index/57",
+		EndpointFloatIndexDailyGraph:          "/api/nots/graph/index/62",
+		EndpointSensitiveFloatIndexDailyGraph: "/api/nots/graph/index/63",
+		EndpointBankingSubIndexGraph:          "/api/nots/graph/index/51",
+		EndpointDevelopmentBankSubIndexGraph:  "/api/nots/graph/index/55",
+		EndpointFinanceSubIndexGraph:          "/api/nots/graph/index/60",
+		EndpointHotelTourismSubIndexGraph:     "/api/nots/graph/index/52",
+		EndpointHydroSubIndexGraph:            "/api/nots/graph/index/54",
+		EndpointInvestmentSubIndexGraph:       "/api/nots/graph/index/67",
+		EndpointLifeInsuranceSubIndexGraph:    "/api/nots/graph/index/65",
+		EndpointManufacturingSubIndexGraph:    "/api/nots/graph/index/56",
+		EndpointMicrofinanceSubIndexGraph:     "/api/nots/graph/index/64",
+		EndpointMutualFundSubIndexGraph:       "/api/nots/graph/index/66",
+		EndpointNonLifeInsuranceSubIndexGraph: "/api/nots/graph/index/59",
+		EndpointOthersSubIndexGraph:           "/api/nots/graph/index/53",
+		EndpointTradingSubIndexGraph:          "/api/nots/graph/index/61",
+		EndpointCompanyDailyGraph:             "/api/nots/market/graphdata/daily/",
+		EndpointCompanyDetails:                "/api/nots/security/",
+		EndpointCompanyPriceVolumeHistory:     "/api/nots/market/history/security/",
+		EndpointCompanyFloorsheet:             "/api/nots/security/floorsheet/",
+		EndpointFloorSheet:                    "/api/nots/nepse-data/floorsheet",
+		EndpointTodaysPrice:                   "/api/nots/nepse-data/today-price",
+		EndpointLiveMarket:                    "/api/nots/lives-market",
+		EndpointMarketDepth:                   "/api/nots/nepse-data/marketdepth/",
+		EndpointBrokers:                       "/api/nots/member",
+		EndpointNews:                          "/api/nots/news/media/news-and-alerts",
+		EndpointFetchFile:                     "/api/nots/security/fetchFiles?fileLocation=",
+		EndpointCompanyDisclosures:            "/api/nots/security/disclosure/",
+		EndpointDividends:                     "/api/nots/security/dividend/",
+		EndpointCorporateEvents:               "/api/nots/security/agm/",
+		EndpointUpcomingIssues:                "/api/nots/issue/upcoming",
+		EndpointDebentures:                    "/api/nots/security/debenture",
+		EndpointHolidays:                      "/api/nots/holiday/list",
+		EndpointIndexConstituents:             "/api/nots/index/constituents/",
+		EndpointSectors:                       "/api/nots/sector",
+		EndpointFiftyTwoWeekHighLow:           "/api/nots/nepse-data/52-week-high-low",
+		EndpointTopMarketCap:                  "/api/nots/top-ten/market-capitalization",
+		EndpointSuspendedSecurities:           "/api/nots/security/suspended",
+		EndpointTodaysPriceCSV:                "/api/nots/market/export/todays-price/",
+		EndpointIndexHistory:                  "/api/nots/index/history/",
+		EndpointBlockTrades:                   "/api/nots/nepse-data/block-trade",
+		EndpointMarketSummaryHistory:          "/api/nots/market-summary-history",
+		EndpointPressReleases:                 "/api/nots/news/press-release",
+		EndpointCirculars:                     "/api/nots/news/circular",
+		EndpointApplicationReports:            "/api/nots/application-reports",
+		EndpointSecuritySearch:                "/api/nots/security/search",
+	}
+}
+
+// Path returns the URL path registered for the endpoint
+func (e Endpoints) Path(endpoint Endpoint) string {
+	return e[endpoint]
+}
+
+// Override replaces (or adds) the URL path for an endpoint
+func (e Endpoints) Override(endpoint Endpoint, path string) error {
+	if err := validateEndpointPath(endpoint, path); err != nil {
+		return err
+	}
+	e[endpoint] = path
+	return nil
+}
+
+// Validate checks that every known endpoint is registered with a usable path
+func (e Endpoints) Validate() error {
+	var problems []string
+	for endpoint := range DefaultEndpoints() {
+		if _, ok := e[endpoint]; !ok {
+			problems = append(problems, fmt.Sprintf("%s: missing", endpoint))
+		}
+	}
+	for endpoint, path := range e {
+		if err := validateEndpointPath(endpoint, path); err != nil {
+			problems = append(problems, err.Message)
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return NewInvalidClientRequestError("invalid endpoint registry: " + strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// validateEndpointPath checks that a path is relative to the base URL
+func validateEndpointPath(endpoint Endpoint, path string) *NepseError {
+	if !strings.HasPrefix(path, "/") {
+		return NewInvalidClientRequestError(fmt.Sprintf("%s: path %q must start with /", endpoint, path))
+	}
+	return nil
 }
 
 // DefaultConfig returns the default NEPSE API configuration
 func DefaultConfig() *Config {
 	return &Config{
-		BaseURL: "https://www.nepalstock.com",
-		APIEndpoints: map[string]string{
-			"price_volume":                       "/api/nots/securityDailyTradeStat/58",
-			"market_summary":                     "/api/nots/market-summary/",
-			"supply_demand":                      "/api/nots/nepse-data/supplydemand",
-			"top_gainers":                        "/api/nots/top-ten/top-gainer",
-			"top_losers":                         "/api/nots/top-ten/top-loser",
-			"top_ten_trade":                      "/api/nots/top-ten/trade",
-			"top_ten_transaction":                "/api/nots/top-ten/transaction",
-			"top_ten_turnover":                   "/api/nots/top-ten/turnover",
-			"market_open":                        "/api/nots/nepse-data/market-open",
-			"nepse_index":                        "/api/nots/nepse-index",
-			"company_list":                       "/api/nots/company/list",
-			"security_list":                      "/api/nots/security?nonDelisted=true",
-			"nepse_index_daily_graph":            "/api/nots/graph/index/58",
-			"sensitive_index_daily_graph":        "/api/nots/graph/index/57",
-			"float_index_daily_graph":            "/api/nots/graph/index/62",
-			"sensitive_float_index_daily_graph":  "/api/nots/graph/index/63",
-			"banking_sub_index_graph":            "/api/nots/graph/index/51",
-			"development_bank_sub_index_graph":   "/api/nots/graph/index/55",
-			"finance_sub_index_graph":            "/api/nots/graph/index/60",
-			"hotel_tourism_sub_index_graph":      "/api/nots/graph/index/52",
-			"hydro_sub_index_graph":              "/api/nots/graph/index/54",
-			"investment_sub_index_graph":         "/api/nots/graph/index/67",
-			"life_insurance_sub_index_graph":     "/api/nots/graph/index/65",
-			"manufacturing_sub_index_graph":      "/api/nots/graph/index/56",
-			"microfinance_sub_index_graph":       "/api/nots/graph/index/64",
-			"mutual_fund_sub_index_graph":        "/api/nots/graph/index/66",
-			"non_life_insurance_sub_index_graph": "/api/nots/graph/index/59",
-			"others_sub_index_graph":             "/api/nots/graph/index/53",
-			"trading_sub_index_graph":            "/api/nots/graph/index/61",
-			"company_daily_graph":                "/api/nots/market/graphdata/daily/",
-			"company_details":                    "/api/nots/security/",
-			"company_price_volume_history":       "/api/nots/market/history/security/",
-			"company_floorsheet":                 "/api/nots/security/floorsheet/",
-			"floor_sheet":                        "/api/nots/nepse-data/floorsheet",
-			"todays_price":                       "/api/nots/nepse-data/today-price",
-			"live_market":                        "/api/nots/lives-market",
-			"market_depth":                       "/api/nots/nepse-data/marketdepth/",
-		},
+		BaseURL:   "https://www.nepalstock.com",
+		Endpoints: DefaultEndpoints(),
         Headers: map[string]string{
             "User-Agent":      "Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:89.0) Gecko/20100101 Firefox/89.0",
             "Accept":          "application/json, text/plain, */*",
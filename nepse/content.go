@@ -0,0 +1,134 @@
+package nepse
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// GetNews retrieves a page of exchange news, alerts and circulars.
+// Pages are zero-based.
+func (h *HTTPClient) GetNews(ctx context.Context, page, size int) ([]NewsItem, error) {
+	if err := validatePage(page, size); err != nil {
+		return nil, err
+	}
+
+	endpoint := withQuery(h.endpoint(EndpointNews), url.Values{"size": {strconv.Itoa(size)}})
+
+	p, err := fetchPage[NewsItem](ctx, h, endpoint, int32(page))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get news: %w", err)
+	}
+
+	news := p.Content
+	for i := range news {
+		news[i].AttachmentURL = h.fileURL(news[i].FilePath)
+	}
+	return news, nil
+}
+
+// GetCompanyDisclosures retrieves the disclosure feed of a listed company by security ID
+func (h *HTTPClient) GetCompanyDisclosures(ctx context.Context, securityID int32) ([]CompanyDisclosure, error) {
+	if err := validateID("security ID", securityID); err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("%s%d", h.endpoint(EndpointCompanyDisclosures), securityID)
+
+	var disclosures []CompanyDisclosure
+	err := h.apiRequest(ctx, endpoint, &disclosures)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get disclosures for security %d: %w", securityID, err)
+	}
+
+	for i := range disclosures {
+		disclosures[i].DocumentURL = h.fileURL(disclosures[i].FilePath)
+	}
+	return disclosures, nil
+}
+
+// GetCompanyDisclosuresBySymbol retrieves the disclosure feed of a listed company by symbol
+func (h *HTTPClient) GetCompanyDisclosuresBySymbol(ctx context.Context, symbol string) ([]CompanyDisclosure, error) {
+	security, err := h.findSecurityBySymbol(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find security %s: %w", symbol, err)
+	}
+	return h.GetCompanyDisclosures(ctx, security.ID)
+}
+
+// GetPressReleases retrieves a page of exchange press releases
+func (h *HTTPClient) GetPressReleases(ctx context.Context, page, size int) ([]Publication, error) {
+	return h.getPublications(ctx, EndpointPressReleases, "press releases", page, size)
+}
+
+// GetPressRelease retrieves a single press release by ID
+func (h *HTTPClient) GetPressRelease(ctx context.Context, id int32) (*Publication, error) {
+	return h.getPublication(ctx, EndpointPressReleases, "press release", id)
+}
+
+// GetCirculars retrieves a page of exchange circulars
+func (h *HTTPClient) GetCirculars(ctx context.Context, page, size int) ([]Publication, error) {
+	return h.getPublications(ctx, EndpointCirculars, "circulars", page, size)
+}
+
+// GetCircular retrieves a single circular by ID
+func (h *HTTPClient) GetCircular(ctx context.Context, id int32) (*Publication, error) {
+	return h.getPublication(ctx, EndpointCirculars, "circular", id)
+}
+
+// GetApplicationReports retrieves a page of application reports (IPO/FPO/right share allotment reports)
+func (h *HTTPClient) GetApplicationReports(ctx context.Context, page, size int) ([]Publication, error) {
+	return h.getPublications(ctx, EndpointApplicationReports, "application reports", page, size)
+}
+
+// GetApplicationReport retrieves a single application report by ID
+func (h *HTTPClient) GetApplicationReport(ctx context.Context, id int32) (*Publication, error) {
+	return h.getPublication(ctx, EndpointApplicationReports, "application report", id)
+}
+
+// getPublications lists a page of a document-style content endpoint
+func (h *HTTPClient) getPublications(ctx context.Context, endpoint Endpoint, what string, page, size int) ([]Publication, error) {
+	if err := validatePage(page, size); err != nil {
+		return nil, err
+	}
+
+	path := withQuery(h.endpoint(endpoint), url.Values{"size": {strconv.Itoa(size)}})
+
+	p, err := fetchPage[Publication](ctx, h, path, int32(page))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", what, err)
+	}
+
+	publications := p.Content
+	for i := range publications {
+		publications[i].DocumentURL = h.fileURL(publications[i].FilePath)
+	}
+	return publications, nil
+}
+
+// getPublication fetches one document of a document-style content endpoint
+func (h *HTTPClient) getPublication(ctx context.Context, endpoint Endpoint, what string, id int32) (*Publication, error) {
+	if err := validateID(what+" ID", id); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("%s/%d", h.endpoint(endpoint), id)
+
+	var publication Publication
+	err := h.apiRequest(ctx, path, &publication)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s %d: %w", what, id, err)
+	}
+
+	publication.DocumentURL = h.fileURL(publication.FilePath)
+	return &publication, nil
+}
+
+// fileURL resolves a NEPSE file location into a downloadable URL
+func (h *HTTPClient) fileURL(location string) string {
+	if location == "" {
+		return ""
+	}
+	return h.config.BaseURL + h.endpoint(EndpointFetchFile) + url.QueryEscape(location)
+}
@@ -0,0 +1,68 @@
+package nepse
+
+import (
+	"context"
+	"fmt"
+)
+
+// Corporate Action Methods
+
+// GetDividendHistory retrieves bonus and cash dividends declared by a company, by security ID
+func (h *HTTPClient) GetDividendHistory(ctx context.Context, securityID int32) ([]Dividend, error) {
+	if err := validateID("security ID", securityID); err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("%s%d", h.endpoint(EndpointDividends), securityID)
+
+	var dividends []Dividend
+	err := h.apiRequest(ctx, endpoint, &dividends)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dividend history for security %d: %w", securityID, err)
+	}
+	return dividends, nil
+}
+
+// GetDividendHistoryBySymbol retrieves bonus and cash dividends declared by a company, by symbol
+func (h *HTTPClient) GetDividendHistoryBySymbol(ctx context.Context, symbol string) ([]Dividend, error) {
+	security, err := h.findSecurityBySymbol(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find security %s: %w", symbol, err)
+	}
+	return h.GetDividendHistory(ctx, security.ID)
+}
+
+// GetCorporateEvents retrieves AGM/SGM dates and book-closure dates of a company, by security ID
+func (h *HTTPClient) GetCorporateEvents(ctx context.Context, securityID int32) ([]CorporateEvent, error) {
+	if err := validateID("security ID", securityID); err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("%s%d", h.endpoint(EndpointCorporateEvents), securityID)
+
+	var events []CorporateEvent
+	err := h.apiRequest(ctx, endpoint, &events)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get corporate events for security %d: %w", securityID, err)
+	}
+	return events, nil
+}
+
+// GetCorporateEventsBySymbol retrieves AGM/SGM dates and book-closure dates of a company, by symbol
+func (h *HTTPClient) GetCorporateEventsBySymbol(ctx context.Context, symbol string) ([]CorporateEvent, error) {
+	security, err := h.findSecurityBySymbol(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find security %s: %w", symbol, err)
+	}
+	return h.GetCorporateEvents(ctx, security.ID)
+}
+
+// GetUpcomingIssues retrieves IPOs, FPOs, right shares and debenture issues in the NEPSE pipeline
+func (h *HTTPClient) GetUpcomingIssues(ctx context.Context) ([]Issue, error) {
+	var issues []Issue
+	err := h.apiRequest(ctx, h.endpoint(EndpointUpcomingIssues), &issues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upcoming issues: %w", err)
+	}
+	return issues, nil
+}
@@ -0,0 +1,172 @@
+package nepse
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CSVMarshaler is implemented by types that choose their own CSV columns.
+// Other structs are marshaled by reflection: one column per scalar field, named
+// by its `csv` tag, or its `json` tag if it has none. Fields tagged "-" and
+// nested slices, maps and structs (such as market depth levels) are skipped.
+type CSVMarshaler interface {
+	CSVHeader() []string
+	CSVRecord() []string
+}
+
+// CSVHeader returns the column names used for rows of type T
+func CSVHeader[T any]() []string {
+	var zero T
+	if m, ok := any(&zero).(CSVMarshaler); ok {
+		return m.CSVHeader()
+	}
+	fields := csvFieldsOf(reflect.TypeFor[T]())
+	header := make([]string, len(fields))
+	for i, f := range fields {
+		header[i] = f.name
+	}
+	return header
+}
+
+// CSVRecord returns the CSV cells of one row, in CSVHeader order. Absent Null
+// values and zero timestamps are empty cells.
+func CSVRecord[T any](row *T) []string {
+	if m, ok := any(row).(CSVMarshaler); ok {
+		return m.CSVRecord()
+	}
+	v := reflect.ValueOf(row).Elem()
+	fields := csvFieldsOf(v.Type())
+	record := make([]string, len(fields))
+	for i, f := range fields {
+		record[i] = formatCSVValue(v.FieldByIndex(f.index))
+	}
+	return record
+}
+
+// WriteCSV writes a header and one record per row to w, e.g.
+//
+//	prices, _ := client.GetTodaysPrices(ctx, "")
+//	err := nepse.WriteCSV(os.Stdout, prices)
+func WriteCSV[T any](w io.Writer, rows []T) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(CSVHeader[T]()); err != nil {
+		return err
+	}
+	for i := range rows {
+		if err := cw.Write(CSVRecord(&rows[i])); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// csvField is one column of a reflected struct
+type csvField struct {
+	name  string
+	index []int
+}
+
+// csvFieldCache memoises the columns of each struct type
+var csvFieldCache sync.Map
+
+// timestampType and timeType are formatted as values rather than nested structs
+var (
+	timestampType = reflect.TypeFor[Timestamp]()
+	timeType      = reflect.TypeFor[time.Time]()
+)
+
+// csvFieldsOf returns the CSV columns of a struct type
+func csvFieldsOf(t reflect.Type) []csvField {
+	if cached, ok := csvFieldCache.Load(t); ok {
+		return cached.([]csvField)
+	}
+	if t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("nepse: cannot marshal %s as CSV, want a struct", t))
+	}
+
+	var fields []csvField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() || !isCSVScalar(f.Type) {
+			continue
+		}
+		name, ok := f.Tag.Lookup("csv")
+		if !ok {
+			name = f.Tag.Get("json")
+		}
+		name, _, _ = strings.Cut(name, ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, csvField{name: name, index: f.Index})
+	}
+	csvFieldCache.Store(t, fields)
+	return fields
+}
+
+// isCSVScalar reports whether a field type fits in a single CSV cell
+func isCSVScalar(t reflect.Type) bool {
+	if t == timestampType || t == timeType || t.Implements(nullValueType) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// formatCSVValue formats a scalar field value as a CSV cell
+func formatCSVValue(v reflect.Value) string {
+	switch x := v.Interface().(type) {
+	case Timestamp:
+		return x.String()
+	case Money:
+		return x.String()
+	case time.Time:
+		if x.IsZero() {
+			return ""
+		}
+		return x.Format(time.RFC3339)
+	case nullValue:
+		value, ok := x.nullValue()
+		if !ok {
+			return ""
+		}
+		return formatCSVValue(reflect.ValueOf(value))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// nullValue is implemented by every Null instantiation, so reflection can read them
+type nullValue interface {
+	nullValue() (any, bool)
+}
+
+var nullValueType = reflect.TypeFor[nullValue]()
@@ -0,0 +1,171 @@
+package nepse
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// DepthSide is the side of the order book a level belongs to
+type DepthSide string
+
+const (
+	DepthSideBuy  DepthSide = "buy"
+	DepthSideSell DepthSide = "sell"
+)
+
+// DepthChangeKind says how a price level changed between two snapshots
+type DepthChangeKind string
+
+const (
+	DepthLevelAdded   DepthChangeKind = "added"
+	DepthLevelRemoved DepthChangeKind = "removed"
+	DepthLevelChanged DepthChangeKind = "changed"
+)
+
+// DepthLevelChange is one price level that appeared, disappeared or changed size.
+// The Previous fields are zero for added levels; Quantity and Orders are zero for
+// removed ones.
+type DepthLevelChange struct {
+	Side             DepthSide       `json:"side"`
+	Kind             DepthChangeKind `json:"kind"`
+	Price            float64         `json:"price"`
+	Quantity         int64           `json:"quantity"`
+	Orders           int32           `json:"orders"`
+	PreviousQuantity int64           `json:"previousQuantity"`
+	PreviousOrders   int32           `json:"previousOrders"`
+}
+
+// DepthDiff is the change of a security's order book between two polls, with
+// the book's new totals
+type DepthDiff struct {
+	SecurityID        int32              `json:"securityId"`
+	Symbol            string             `json:"symbol"`
+	Time              time.Time          `json:"time"`
+	Changes           []DepthLevelChange `json:"changes"`
+	TotalBuyQuantity  int64              `json:"totalBuyQty"`
+	TotalSellQuantity int64              `json:"totalSellQty"`
+}
+
+// depthLevel is one price level of an order book side
+type depthLevel struct {
+	quantity int64
+	orders   int32
+}
+
+// DiffMarketDepth returns the level changes that turn prev into next, buy side
+// first, each side in book order (best price first). A nil prev is an empty
+// book, so every level of next is reported as added.
+func DiffMarketDepth(prev, next *MarketDepth) []DepthLevelChange {
+	if prev == nil {
+		prev = &MarketDepth{}
+	}
+	if next == nil {
+		next = &MarketDepth{}
+	}
+	changes := diffDepthSide(DepthSideBuy, depthLevels(prev.BuyDepth), depthLevels(next.BuyDepth))
+	return append(changes, diffDepthSide(DepthSideSell, depthLevels(prev.SellDepth), depthLevels(next.SellDepth))...)
+}
+
+// depthLevels indexes a book side by price, merging repeated prices
+func depthLevels(side []struct {
+	Price    float64 `json:"price"`
+	Quantity int64   `json:"quantity"`
+	Orders   int32   `json:"orders"`
+}) map[float64]depthLevel {
+	levels := make(map[float64]depthLevel, len(side))
+	for _, l := range side {
+		level := levels[l.Price]
+		level.quantity += l.Quantity
+		level.orders += l.Orders
+		levels[l.Price] = level
+	}
+	return levels
+}
+
+func diffDepthSide(side DepthSide, prev, next map[float64]depthLevel) []DepthLevelChange {
+	var changes []DepthLevelChange
+	for price, n := range next {
+		p, existed := prev[price]
+		switch {
+		case !existed:
+			changes = append(changes, DepthLevelChange{Side: side, Kind: DepthLevelAdded, Price: price, Quantity: n.quantity, Orders: n.orders})
+		case p != n:
+			changes = append(changes, DepthLevelChange{Side: side, Kind: DepthLevelChanged, Price: price, Quantity: n.quantity, Orders: n.orders, PreviousQuantity: p.quantity, PreviousOrders: p.orders})
+		}
+	}
+	for price, p := range prev {
+		if _, ok := next[price]; !ok {
+			changes = append(changes, DepthLevelChange{Side: side, Kind: DepthLevelRemoved, Price: price, PreviousQuantity: p.quantity, PreviousOrders: p.orders})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if side == DepthSideBuy {
+			return changes[i].Price > changes[j].Price
+		}
+		return changes[i].Price < changes[j].Price
+	})
+	return changes
+}
+
+// SubscribeMarketDepth polls the order book of a security every interval and
+// sends the levels that changed since the previous poll. The first diff holds
+// the whole book as added levels; polls with no change send nothing. Poll errors
+// are logged and retried with backoff.
+func (h *HTTPClient) SubscribeMarketDepth(ctx context.Context, securityID int32, interval time.Duration) (<-chan DepthDiff, error) {
+	if err := validateID("security ID", securityID); err != nil {
+		return nil, err
+	}
+	if err := validateInterval(interval); err != nil {
+		return nil, err
+	}
+
+	diffs := newOutbox(h, mergeDepthDiffs)
+	go func() {
+		defer diffs.close()
+		var last *MarketDepth
+		feed := Feed{Kind: FeedMarketDepth, Key: strconv.Itoa(int(securityID)), Interval: interval}
+		fetch := func(ctx context.Context) (*MarketDepth, error) {
+			return h.GetMarketDepth(ctx, securityID)
+		}
+		streamFeed(ctx, h.streamTransport(), feed, fetch, func(ctx context.Context, depth *MarketDepth, err error) error {
+			if err != nil {
+				if ctx.Err() == nil {
+					h.logger.Debug("market depth poll failed", "securityId", securityID, "error", err)
+				}
+				return err
+			}
+			changes := DiffMarketDepth(last, depth)
+			if last != nil && len(changes) == 0 && depth.TotalBuyQuantity == last.TotalBuyQuantity && depth.TotalSellQuantity == last.TotalSellQuantity {
+				return nil
+			}
+			diff := DepthDiff{
+				SecurityID:        depth.SecurityID,
+				Symbol:            depth.Symbol,
+				Time:              time.Now().In(NepalLocation),
+				Changes:           changes,
+				TotalBuyQuantity:  depth.TotalBuyQuantity,
+				TotalSellQuantity: depth.TotalSellQuantity,
+			}
+			if diff.SecurityID == 0 {
+				diff.SecurityID = securityID
+			}
+			if diffs.send(ctx, diff) {
+				last = depth
+			}
+			return nil
+		})
+	}()
+	return diffs.ch, nil
+}
+
+// SubscribeMarketDepthBySymbol is SubscribeMarketDepth for a symbol
+func (h *HTTPClient) SubscribeMarketDepthBySymbol(ctx context.Context, symbol string, interval time.Duration) (<-chan DepthDiff, error) {
+	security, err := h.findSecurityBySymbol(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find security %s: %w", symbol, err)
+	}
+	return h.SubscribeMarketDepth(ctx, security.ID, interval)
+}
@@ -0,0 +1,231 @@
+package nepse
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DiagnosticStatus is the outcome of a single diagnostic check
+type DiagnosticStatus string
+
+const (
+	DiagnosticPass DiagnosticStatus = "pass"
+	DiagnosticWarn DiagnosticStatus = "warn"
+	DiagnosticFail DiagnosticStatus = "fail"
+	DiagnosticSkip DiagnosticStatus = "skip"
+)
+
+// DiagnosticCheck holds the result of one step of Diagnose
+type DiagnosticCheck struct {
+	Name     string           `json:"name"`
+	Status   DiagnosticStatus `json:"status"`
+	Detail   string           `json:"detail"`
+	Hint     string           `json:"hint,omitempty"`
+	Duration time.Duration    `json:"duration"`
+}
+
+// DiagnosticReport is the result of Diagnose
+type DiagnosticReport struct {
+	BaseURL   string            `json:"baseUrl"`
+	StartedAt time.Time         `json:"startedAt"`
+	Checks    []DiagnosticCheck `json:"checks"`
+}
+
+// OK returns true if no check failed
+func (r *DiagnosticReport) OK() bool {
+	for _, c := range r.Checks {
+		if c.Status == DiagnosticFail {
+			return false
+		}
+	}
+	return true
+}
+
+// String renders the report as a human readable multi-line summary
+func (r *DiagnosticReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "NEPSE diagnostics for %s\n", r.BaseURL)
+	for _, c := range r.Checks {
+		fmt.Fprintf(&b, "[%s] %s: %s (%s)\n", strings.ToUpper(string(c.Status)), c.Name, c.Detail, c.Duration.Round(time.Millisecond))
+		if c.Hint != "" {
+			fmt.Fprintf(&b, "       hint: %s\n", c.Hint)
+		}
+	}
+	return b.String()
+}
+
+// Hints used when NEPSE blocks a request
+const (
+	hintForbidden = "NEPSE answers 403 to networks it does not trust (non-Nepal IPs, cloud/VPN ranges) " +
+		"and to clients that do not look like a browser; try from a Nepal-based network and keep the default Config.Headers"
+	hintTLS = "nepalstock.com has served incomplete certificate chains in the past; " +
+		"set TLSVerification to false only if you accept the risk"
+)
+
+// Diagnose runs a battery of connectivity checks against the NEPSE API
+// (DNS, TLS handshake, token prove endpoint, an authenticated call and
+// header sanity) and returns a report describing what failed and why.
+// If options is nil, default options will be used.
+// The returned error is non-nil only if the report itself could not be built.
+func Diagnose(ctx context.Context, options *Options) (*DiagnosticReport, error) {
+	if options == nil {
+		options = DefaultOptions()
+	}
+	// Work on a copy so the caller's options aren't mutated, and fail fast
+	opts := *options
+	opts.MaxRetries = 0
+	if opts.Config == nil {
+		opts.Config = DefaultConfig()
+	}
+
+	baseURL, err := resolveBaseURL(&opts)
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Hostname() == "" {
+		return nil, NewInvalidClientRequestError(fmt.Sprintf("invalid base URL %q", baseURL))
+	}
+	host := u.Hostname()
+	port := u.Port()
+	if port == "" {
+		port = "443"
+		if u.Scheme == "http" {
+			port = "80"
+		}
+	}
+
+	report := &DiagnosticReport{
+		BaseURL:   baseURL,
+		StartedAt: time.Now(),
+	}
+
+	// 1) DNS
+	dns := runCheck("dns", func() (DiagnosticStatus, string, string) {
+		addrs, err := net.DefaultResolver.LookupHost(ctx, host)
+		if err != nil {
+			return DiagnosticFail, err.Error(), "check network connectivity and DNS resolver settings"
+		}
+		return DiagnosticPass, fmt.Sprintf("%s resolves to %s", host, strings.Join(addrs, ", ")), ""
+	})
+	report.Checks = append(report.Checks, dns)
+
+	// 2) TLS handshake (always verified, so certificate problems are visible)
+	tlsCheck := runCheck("tls", func() (DiagnosticStatus, string, string) {
+		if u.Scheme != "https" {
+			return DiagnosticSkip, "base URL is not https", ""
+		}
+		if dns.Status == DiagnosticFail {
+			return DiagnosticSkip, "skipped because DNS failed", ""
+		}
+		dialer := &tls.Dialer{Config: &tls.Config{ServerName: host}}
+		conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, port))
+		if err != nil {
+			var certErr *tls.CertificateVerificationError
+			if errors.As(err, &certErr) {
+				if !opts.TLSVerification {
+					return DiagnosticWarn, "certificate verification failed (ignored, TLSVerification is off): " + err.Error(), ""
+				}
+				return DiagnosticFail, "certificate verification failed: " + err.Error(), hintTLS
+			}
+			return DiagnosticFail, err.Error(), "the host may be blocking TLS connections from this network"
+		}
+		defer conn.Close()
+		state := conn.(*tls.Conn).ConnectionState()
+		return DiagnosticPass, fmt.Sprintf("handshake ok (%s)", tls.VersionName(state.Version)), ""
+	})
+	report.Checks = append(report.Checks, tlsCheck)
+
+	// 3) Header sanity / fingerprint hints
+	report.Checks = append(report.Checks, runCheck("headers", func() (DiagnosticStatus, string, string) {
+		var missing []string
+		for _, h := range []string{"User-Agent", "Accept"} {
+			if opts.Config.Headers[h] == "" {
+				missing = append(missing, h)
+			}
+		}
+		if len(missing) > 0 {
+			return DiagnosticWarn, "missing headers: " + strings.Join(missing, ", "),
+				"NEPSE tends to reject requests without browser-like headers; restore them from DefaultConfig()"
+		}
+		return DiagnosticPass, "browser-like headers configured",
+			"Go's TLS fingerprint differs from browsers; if requests are still blocked, a proxy on a trusted network may be required"
+	}))
+
+	client, err := NewHTTPClient(&opts)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close(context.Background())
+
+	// 4) Token prove endpoint (unauthenticated)
+	prove := runCheck("prove", func() (DiagnosticStatus, string, string) {
+		if dns.Status == DiagnosticFail {
+			return DiagnosticSkip, "skipped because DNS failed", ""
+		}
+		if _, err := client.GetTokens(ctx); err != nil {
+			return DiagnosticFail, err.Error(), hintFor(err)
+		}
+		return DiagnosticPass, "token prove endpoint answered", ""
+	})
+	report.Checks = append(report.Checks, prove)
+
+	// 5) Authenticated call
+	report.Checks = append(report.Checks, runCheck("authenticated", func() (DiagnosticStatus, string, string) {
+		if prove.Status != DiagnosticPass {
+			return DiagnosticSkip, "skipped because the prove endpoint failed", ""
+		}
+		status, err := client.GetMarketStatus(ctx)
+		if err != nil {
+			return DiagnosticFail, err.Error(), hintFor(err)
+		}
+		return DiagnosticPass, fmt.Sprintf("market status %s as of %s", status.IsOpen, status.AsOf), ""
+	}))
+
+	return report, nil
+}
+
+// runCheck times fn and wraps its result into a DiagnosticCheck
+func runCheck(name string, fn func() (DiagnosticStatus, string, string)) DiagnosticCheck {
+	start := time.Now()
+	status, detail, hint := fn()
+	return DiagnosticCheck{
+		Name:     name,
+		Status:   status,
+		Detail:   detail,
+		Hint:     hint,
+		Duration: time.Since(start),
+	}
+}
+
+// hintFor returns an actionable hint for a NEPSE error
+func hintFor(err error) string {
+	var nepseErr *NepseError
+	if !errors.As(err, &nepseErr) {
+		return ""
+	}
+	switch nepseErr.Code {
+	case ErrorTypeUnauthorized:
+		return hintForbidden
+	case ErrorTypeTokenExpired:
+		return "token was rejected; the embedded WASM parser may be out of date with nepalstock.com"
+	case ErrorTypeNetworkError:
+		var certErr *tls.CertificateVerificationError
+		if errors.As(err, &certErr) {
+			return hintTLS
+		}
+		return "the connection failed; check proxies, firewalls and HTTPTimeout"
+	case ErrorTypeRateLimit:
+		return "slow down requests or increase RetryDelay"
+	case ErrorTypeInvalidServerResponse:
+		return "NEPSE is having server-side trouble; retry later"
+	default:
+		return ""
+	}
+}
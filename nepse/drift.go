@@ -0,0 +1,229 @@
+package nepse
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SchemaDrift describes how a response differed from the struct it was decoded
+// into. Paths are dotted JSON keys, with [] for array elements and * for map
+// values, e.g. "content[].securityName".
+type SchemaDrift struct {
+	// Endpoint is the API path the response came from
+	Endpoint string
+	// Type is the Go type the response was decoded into
+	Type string
+	// Unmapped lists keys the response had that no struct field reads
+	Unmapped []string
+	// Missing lists struct fields the response did not include. Fields tagged
+	// omitempty are optional and never reported.
+	Missing []string
+}
+
+// SchemaDriftHandler receives the drift found in a decoded response. It is called
+// synchronously for every response that drifted, so handlers that alert should
+// deduplicate.
+type SchemaDriftHandler func(SchemaDrift)
+
+// checkSchema compares a decoded response with its raw JSON and reports any drift
+// to the configured handler. It costs nothing unless a handler is set.
+func (h *HTTPClient) checkSchema(endpoint string, data []byte, v any) {
+	handler := h.options.SchemaDriftHandler
+	if handler == nil {
+		return
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var doc any
+	if decoder.Decode(&doc) != nil {
+		return
+	}
+
+	t := reflect.TypeOf(v)
+	d := &driftWalker{unmapped: map[string]bool{}, missing: map[string]bool{}}
+	d.walk(doc, t, "")
+	if len(d.unmapped) == 0 && len(d.missing) == 0 {
+		return
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	handler(SchemaDrift{
+		Endpoint: pathOf(endpoint),
+		Type:     t.String(),
+		Unmapped: sortedKeys(d.unmapped),
+		Missing:  sortedKeys(d.missing),
+	})
+}
+
+// pathOf strips the query string from an endpoint
+func pathOf(endpoint string) string {
+	path, _, _ := strings.Cut(endpoint, "?")
+	return path
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// driftWalker collects drifted paths, deduplicated across array elements
+type driftWalker struct {
+	unmapped map[string]bool
+	missing  map[string]bool
+}
+
+// walk compares one JSON value with the Go type it was decoded into
+func (d *driftWalker) walk(node any, t reflect.Type, path string) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if node == nil || isDriftLeaf(t) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := node.(map[string]any)
+		if !ok {
+			return
+		}
+		fields := jsonFieldsOf(t)
+		seen := make(map[string]bool, len(fields))
+		for key, value := range obj {
+			f, ok := matchJSONField(fields, key)
+			if !ok {
+				d.unmapped[joinPath(path, key)] = true
+				continue
+			}
+			seen[f.name] = true
+			d.walk(value, t.FieldByIndex(f.index).Type, joinPath(path, f.name))
+		}
+		for _, f := range fields {
+			if !seen[f.name] && !f.optional {
+				d.missing[joinPath(path, f.name)] = true
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		items, ok := node.([]any)
+		if !ok {
+			return
+		}
+		for _, item := range items {
+			d.walk(item, t.Elem(), path+"[]")
+		}
+	case reflect.Map:
+		obj, ok := node.(map[string]any)
+		if !ok {
+			return
+		}
+		for _, value := range obj {
+			d.walk(value, t.Elem(), joinPath(path, "*"))
+		}
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+var (
+	unmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+	rawMessageType  = reflect.TypeFor[json.RawMessage]()
+)
+
+// isDriftLeaf reports whether a type is decoded as a single value, so its JSON
+// is not compared key by key. Structs with their own UnmarshalJSON still decode
+// through their fields here, so they are walked.
+func isDriftLeaf(t reflect.Type) bool {
+	if t == rawMessageType || t == timestampType || t == reflect.TypeFor[time.Time]() || t.Implements(nullValueType) {
+		return true
+	}
+	if t.Kind() == reflect.Interface {
+		return true
+	}
+	return t.Kind() != reflect.Struct && reflect.PointerTo(t).Implements(unmarshalerType)
+}
+
+// jsonField is one JSON-visible field of a struct
+type jsonField struct {
+	name     string
+	index    []int
+	optional bool
+}
+
+// jsonFieldCache memoises the JSON fields of each struct type
+var jsonFieldCache sync.Map
+
+// jsonFieldsOf returns the fields encoding/json reads for a struct type,
+// including those promoted from embedded structs
+func jsonFieldsOf(t reflect.Type) []jsonField {
+	if cached, ok := jsonFieldCache.Load(t); ok {
+		return cached.([]jsonField)
+	}
+
+	var fields []jsonField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for _, ef := range jsonFieldsOf(ft) {
+					ef.index = append([]int{i}, ef.index...)
+					fields = append(fields, ef)
+				}
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, jsonField{
+			name:     name,
+			index:    f.Index,
+			optional: strings.Contains(opts, "omitempty") || strings.Contains(opts, "omitzero"),
+		})
+	}
+	jsonFieldCache.Store(t, fields)
+	return fields
+}
+
+// matchJSONField finds the field a JSON key decodes into, preferring an exact
+// match and falling back to encoding/json's case-insensitive matching
+func matchJSONField(fields []jsonField, key string) (jsonField, bool) {
+	for _, f := range fields {
+		if f.name == key {
+			return f, true
+		}
+	}
+	for _, f := range fields {
+		if strings.EqualFold(f.name, key) {
+			return f, true
+		}
+	}
+	return jsonField{}, false
+}
@@ -0,0 +1,151 @@
+package nepse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// InstrumentType is the instrument classification of a security. Values decoded
+// from NEPSE are normalised to the constants below; unrecognised instruments are
+// kept verbatim.
+type InstrumentType string
+
+// Instrument types reported in Security.Instrument
+const (
+	InstrumentEquity          InstrumentType = "Equity"
+	InstrumentMutualFund      InstrumentType = "Mutual Funds"
+	InstrumentDebenture       InstrumentType = "Non-Convertible Debentures"
+	InstrumentPreferenceShare InstrumentType = "Preference Shares"
+
+	// InstrumentPromoterShare is not reported by NEPSE; ClassifyInstrument assigns it
+	// to equity securities that are promoter share listings
+	InstrumentPromoterShare InstrumentType = "Promoter Shares"
+)
+
+// instrumentCodes maps each instrument type to NEPSE's short code
+var instrumentCodes = map[InstrumentType]string{
+	InstrumentEquity:          "EQ",
+	InstrumentMutualFund:      "MF",
+	InstrumentDebenture:       "BD",
+	InstrumentPreferenceShare: "PS",
+	InstrumentPromoterShare:   "PR",
+}
+
+// ParseInstrumentType parses an instrument type from its description ("Equity")
+// or short code ("EQ"), ignoring case
+func ParseInstrumentType(s string) (InstrumentType, error) {
+	s = strings.TrimSpace(s)
+	for instrument, code := range instrumentCodes {
+		if strings.EqualFold(s, string(instrument)) || strings.EqualFold(s, code) {
+			return instrument, nil
+		}
+	}
+	return "", NewInvalidClientRequestError(fmt.Sprintf("unknown instrument type %q", s))
+}
+
+// Code returns the short code of the instrument type (e.g. "EQ"), or "" if unknown
+func (t InstrumentType) Code() string {
+	return instrumentCodes[t]
+}
+
+// String returns the instrument description
+func (t InstrumentType) String() string {
+	return string(t)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting descriptions and codes
+func (t *InstrumentType) UnmarshalText(text []byte) error {
+	parsed, err := ParseInstrumentType(string(text))
+	if err != nil {
+		*t = InstrumentType(text)
+		return nil
+	}
+	*t = parsed
+	return nil
+}
+
+// ShareGroup is the NEPSE compliance class of a listed company. Values decoded
+// from NEPSE are normalised to the constants below; unrecognised groups are kept
+// verbatim.
+type ShareGroup string
+
+// Share groups reported in CompanyDetails.ShareGroup
+const (
+	// ShareGroupA holds companies meeting NEPSE's capital, profit and AGM requirements
+	ShareGroupA ShareGroup = "A"
+	// ShareGroupB holds companies that do not qualify for A or Z
+	ShareGroupB ShareGroup = "B"
+	// ShareGroupZ holds companies in default (no AGM or dividend for years)
+	ShareGroupZ ShareGroup = "Z"
+)
+
+// ParseShareGroup parses a share group such as "A", "b" or "Z Class Companies"
+func ParseShareGroup(s string) (ShareGroup, error) {
+	name := strings.ToUpper(strings.TrimSpace(s))
+	name = strings.TrimPrefix(name, "GROUP ")
+	if i := strings.IndexByte(name, ' '); i > 0 {
+		name = name[:i]
+	}
+	for _, group := range []ShareGroup{ShareGroupA, ShareGroupB, ShareGroupZ} {
+		if name == string(group) {
+			return group, nil
+		}
+	}
+	return "", NewInvalidClientRequestError(fmt.Sprintf("unknown share group %q", s))
+}
+
+// String returns the share group name
+func (g ShareGroup) String() string {
+	return string(g)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (g *ShareGroup) UnmarshalText(text []byte) error {
+	parsed, err := ParseShareGroup(string(text))
+	if err != nil {
+		*g = ShareGroup(text)
+		return nil
+	}
+	*g = parsed
+	return nil
+}
+
+// MarketState is the trading state reported by the market status endpoint
+type MarketState string
+
+// Market states reported in MarketStatus.IsOpen
+const (
+	MarketStateOpen    MarketState = "OPEN"
+	MarketStateClosed  MarketState = "CLOSE"
+	MarketStatePreOpen MarketState = "PRE_OPEN"
+)
+
+// ParseMarketState parses a market state such as "OPEN", "close", "CLOSED" or "Pre-Open"
+func ParseMarketState(s string) (MarketState, error) {
+	switch strings.NewReplacer("-", "_", " ", "_").Replace(strings.ToUpper(strings.TrimSpace(s))) {
+	case "OPEN":
+		return MarketStateOpen, nil
+	case "CLOSE", "CLOSED":
+		return MarketStateClosed, nil
+	case "PRE_OPEN", "PREOPEN":
+		return MarketStatePreOpen, nil
+	default:
+		return "", NewInvalidClientRequestError(fmt.Sprintf("unknown market state %q", s))
+	}
+}
+
+// String returns the market state as NEPSE reports it
+func (s MarketState) String() string {
+	return string(s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (s *MarketState) UnmarshalText(text []byte) error {
+	parsed, err := ParseMarketState(string(text))
+	if err != nil {
+		*s = MarketState(text)
+		return nil
+	}
+	*s = parsed
+	return nil
+}
@@ -0,0 +1,178 @@
+package nepse
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Environment variables read by LoadConfig
+const (
+	EnvConfigFile  = "NEPSE_CONFIG_FILE"
+	EnvBaseURL     = "NEPSE_BASE_URL"
+	EnvEnvironment = "NEPSE_ENVIRONMENT"
+	EnvTLSVerify   = "NEPSE_TLS_VERIFY"
+	EnvTimeout     = "NEPSE_TIMEOUT"
+	EnvMaxRetries  = "NEPSE_MAX_RETRIES"
+	EnvRetryDelay  = "NEPSE_RETRY_DELAY"
+	EnvRateLimit   = "NEPSE_RATE_LIMIT"
+	EnvProxy       = "NEPSE_PROXY"
+)
+
+// FileConfig is the JSON layout accepted by LoadConfigFile.
+// Durations use Go syntax, e.g. "30s" or "1m".
+type FileConfig struct {
+	BaseURL         string            `json:"baseUrl,omitempty"`
+	Environment     string            `json:"environment,omitempty"`
+	TLSVerification *bool             `json:"tlsVerification,omitempty"`
+	Timeout         string            `json:"timeout,omitempty"`
+	MaxRetries      *int              `json:"maxRetries,omitempty"`
+	RetryDelay      string            `json:"retryDelay,omitempty"`
+	RateLimit       *float64          `json:"rateLimit,omitempty"`
+	Proxy           string            `json:"proxy,omitempty"`
+	Headers         map[string]string `json:"headers,omitempty"`
+	Endpoints       map[string]string `json:"endpoints,omitempty"`
+}
+
+// LoadConfig builds client options from DefaultOptions(), then the JSON file
+// named by NEPSE_CONFIG_FILE (if set), then the NEPSE_* environment variables.
+// Later sources win, so environment variables override the file.
+func LoadConfig() (*Options, error) {
+	options := DefaultOptions()
+
+	if path := os.Getenv(EnvConfigFile); path != "" {
+		if err := applyConfigFile(options, path); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := applyEnv(options); err != nil {
+		return nil, err
+	}
+	return options, nil
+}
+
+// LoadConfigFile builds client options from DefaultOptions() and a JSON config file
+func LoadConfigFile(path string) (*Options, error) {
+	options := DefaultOptions()
+	if err := applyConfigFile(options, path); err != nil {
+		return nil, err
+	}
+	return options, nil
+}
+
+// applyConfigFile overlays the settings from a JSON config file onto options
+func applyConfigFile(options *Options, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return NewInvalidClientRequestError(fmt.Sprintf("failed to read config file %s: %v", path, err))
+	}
+
+	var fc FileConfig
+	if err := json.Unmarshal(data, &fc); err != nil {
+		return NewInvalidClientRequestError(fmt.Sprintf("failed to parse config file %s: %v", path, err))
+	}
+
+	if fc.Environment != "" {
+		options.Environment = Environment(fc.Environment)
+	}
+	if fc.BaseURL != "" {
+		options.BaseURL = fc.BaseURL
+	}
+	if fc.TLSVerification != nil {
+		options.TLSVerification = *fc.TLSVerification
+	}
+	if fc.Timeout != "" {
+		d, err := time.ParseDuration(fc.Timeout)
+		if err != nil {
+			return NewInvalidClientRequestError(fmt.Sprintf("invalid timeout %q in %s", fc.Timeout, path))
+		}
+		options.HTTPTimeout = d
+	}
+	if fc.MaxRetries != nil {
+		options.MaxRetries = *fc.MaxRetries
+	}
+	if fc.RetryDelay != "" {
+		d, err := time.ParseDuration(fc.RetryDelay)
+		if err != nil {
+			return NewInvalidClientRequestError(fmt.Sprintf("invalid retryDelay %q in %s", fc.RetryDelay, path))
+		}
+		options.RetryDelay = d
+	}
+	if fc.RateLimit != nil {
+		options.RateLimit = *fc.RateLimit
+	}
+	if fc.Proxy != "" {
+		options.Proxy = fc.Proxy
+	}
+	if len(fc.Headers) > 0 {
+		if options.Config == nil {
+			options.Config = DefaultConfig()
+		}
+		for k, v := range fc.Headers {
+			options.Config.Headers[k] = v
+		}
+	}
+	if len(fc.Endpoints) > 0 {
+		if options.Config == nil {
+			options.Config = DefaultConfig()
+		}
+		for k, v := range fc.Endpoints {
+			if err := options.Config.Endpoints.Override(Endpoint(k), v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// applyEnv overlays the NEPSE_* environment variables onto options
+func applyEnv(options *Options) error {
+	if v := os.Getenv(EnvEnvironment); v != "" {
+		options.Environment = Environment(v)
+	}
+	if v := os.Getenv(EnvBaseURL); v != "" {
+		options.BaseURL = v
+	}
+	if v := os.Getenv(EnvTLSVerify); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return NewInvalidClientRequestError(fmt.Sprintf("invalid %s %q", EnvTLSVerify, v))
+		}
+		options.TLSVerification = b
+	}
+	if v := os.Getenv(EnvTimeout); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return NewInvalidClientRequestError(fmt.Sprintf("invalid %s %q", EnvTimeout, v))
+		}
+		options.HTTPTimeout = d
+	}
+	if v := os.Getenv(EnvMaxRetries); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return NewInvalidClientRequestError(fmt.Sprintf("invalid %s %q", EnvMaxRetries, v))
+		}
+		options.MaxRetries = n
+	}
+	if v := os.Getenv(EnvRetryDelay); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return NewInvalidClientRequestError(fmt.Sprintf("invalid %s %q", EnvRetryDelay, v))
+		}
+		options.RetryDelay = d
+	}
+	if v := os.Getenv(EnvRateLimit); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return NewInvalidClientRequestError(fmt.Sprintf("invalid %s %q", EnvRateLimit, v))
+		}
+		options.RateLimit = f
+	}
+	if v := os.Getenv(EnvProxy); v != "" {
+		options.Proxy = v
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package nepse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Environment names a well-known NEPSE deployment the client can target
+type Environment string
+
+const (
+	// EnvironmentProduction is the public NEPSE site
+	EnvironmentProduction Environment = "production"
+
+	// EnvironmentNewWeb is the newweb.nepalstock.com mirror
+	EnvironmentNewWeb Environment = "newweb"
+
+	// EnvironmentLocal is a mock or proxy server running on localhost
+	EnvironmentLocal Environment = "local"
+)
+
+// environmentBaseURLs maps each environment to its base URL
+var environmentBaseURLs = map[Environment]string{
+	EnvironmentProduction: DefaultBaseURL,
+	EnvironmentNewWeb:     "https://newweb.nepalstock.com",
+	EnvironmentLocal:      "http://localhost:8080",
+}
+
+// BaseURL returns the base URL of the environment
+func (e Environment) BaseURL() (string, error) {
+	baseURL, ok := environmentBaseURLs[e]
+	if !ok {
+		return "", NewInvalidClientRequestError(fmt.Sprintf("unknown environment %q", e))
+	}
+	return baseURL, nil
+}
+
+// resolveBaseURL picks the base URL the client talks to.
+// Options.BaseURL wins, then Options.Environment, then Config.BaseURL.
+func resolveBaseURL(options *Options) (string, error) {
+	baseURL := options.BaseURL
+	if baseURL == "" && options.Environment != "" {
+		var err error
+		if baseURL, err = options.Environment.BaseURL(); err != nil {
+			return "", err
+		}
+	}
+	if baseURL == "" && options.Config != nil {
+		baseURL = options.Config.BaseURL
+	}
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return strings.TrimSuffix(baseURL, "/"), nil
+}
@@ -1,37 +1,126 @@
 package nepse
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 )
 
-// NepseError represents different types of NEPSE API errors
-type NepseError struct {
-	Type    ErrorType
-	Message string
-	Err     error
-}
+// ErrorCode classifies a NEPSE error. The code set is:
+//
+//   - invalid_client_request: the request was rejected (locally or by NEPSE with 400)
+//   - invalid_argument: an argument (date, ID, page) was malformed and no request
+//     was sent; it also matches ErrInvalidClientRequest
+//   - invalid_server_response: NEPSE answered 5xx or with a body that could not be used
+//   - token_expired: the access token was rejected (401)
+//   - network_error: the request did not complete (DNS, TLS, timeout, cancellation)
+//   - unauthorized: NEPSE refused the client (403), usually a blocked network
+//   - not_found: the resource or symbol does not exist (404 or no match)
+//   - rate_limit: too many requests (429); RetryAfter says how long to wait
+//   - internal_error: a bug or unexpected condition inside the client
+type ErrorCode string
 
-// ErrorType represents the category of NEPSE error
-type ErrorType string
+// ErrorType is the former name of ErrorCode.
+//
+// Deprecated: use ErrorCode.
+type ErrorType = ErrorCode
 
 const (
-	ErrorTypeInvalidClientRequest  ErrorType = "invalid_client_request"
-	ErrorTypeInvalidServerResponse ErrorType = "invalid_server_response"
-	ErrorTypeTokenExpired          ErrorType = "token_expired"
-	ErrorTypeNetworkError          ErrorType = "network_error"
-	ErrorTypeUnauthorized          ErrorType = "unauthorized"
-	ErrorTypeNotFound              ErrorType = "not_found"
-	ErrorTypeRateLimit             ErrorType = "rate_limit"
-	ErrorTypeInternal              ErrorType = "internal_error"
+	ErrorTypeInvalidClientRequest  ErrorCode = "invalid_client_request"
+	ErrorTypeInvalidArgument       ErrorCode = "invalid_argument"
+	ErrorTypeInvalidServerResponse ErrorCode = "invalid_server_response"
+	ErrorTypeTokenExpired          ErrorCode = "token_expired"
+	ErrorTypeNetworkError          ErrorCode = "network_error"
+	ErrorTypeUnauthorized          ErrorCode = "unauthorized"
+	ErrorTypeNotFound              ErrorCode = "not_found"
+	ErrorTypeRateLimit             ErrorCode = "rate_limit"
+	ErrorTypeInternal              ErrorCode = "internal_error"
 )
 
+// Sentinel errors, one per ErrorCode. Every *NepseError matches the sentinel of
+// its code with errors.Is, e.g. errors.Is(err, nepse.ErrNotFound).
+var (
+	ErrInvalidClientRequest  = errors.New("nepse: invalid client request")
+	ErrInvalidArgument       = errors.New("nepse: invalid argument")
+	ErrInvalidServerResponse = errors.New("nepse: invalid server response")
+	ErrTokenExpired          = errors.New("nepse: access token expired")
+	ErrNetworkError          = errors.New("nepse: network request failed")
+	ErrUnauthorized          = errors.New("nepse: unauthorized")
+	ErrNotFound              = errors.New("nepse: not found")
+	ErrRateLimit             = errors.New("nepse: rate limit exceeded")
+	ErrInternal              = errors.New("nepse: internal error")
+)
+
+// sentinels maps each code to its sentinel error
+var sentinels = map[ErrorCode]error{
+	ErrorTypeInvalidClientRequest:  ErrInvalidClientRequest,
+	ErrorTypeInvalidArgument:       ErrInvalidArgument,
+	ErrorTypeInvalidServerResponse: ErrInvalidServerResponse,
+	ErrorTypeTokenExpired:          ErrTokenExpired,
+	ErrorTypeNetworkError:          ErrNetworkError,
+	ErrorTypeUnauthorized:          ErrUnauthorized,
+	ErrorTypeNotFound:              ErrNotFound,
+	ErrorTypeRateLimit:             ErrRateLimit,
+	ErrorTypeInternal:              ErrInternal,
+}
+
+// NepseError is the error returned by the client. Code classifies it; Endpoint,
+// HTTPStatus and RetryAfter are set when the error came from an HTTP response,
+// and API calls also record the Method, the number of HTTP Attempts and the
+// Elapsed time of the failed request.
+type NepseError struct {
+	Code       ErrorCode
+	Message    string
+	Endpoint   string
+	HTTPStatus int
+	RetryAfter time.Duration
+	Method     string
+	Attempts   int
+	Elapsed    time.Duration
+	Err        error
+}
+
 // Error implements the error interface
 func (e *NepseError) Error() string {
+	msg := fmt.Sprintf("nepse %s: %s", e.Code, e.Message)
+	if e.Endpoint != "" {
+		request := e.Endpoint
+		if e.Method != "" {
+			request = e.Method + " " + request
+		}
+		if e.Attempts > 0 {
+			request += fmt.Sprintf(", attempts %d", e.Attempts)
+		}
+		if e.Elapsed > 0 {
+			request += ", " + e.Elapsed.Round(time.Millisecond).String()
+		}
+		msg += " [" + request + "]"
+	}
 	if e.Err != nil {
-		return fmt.Sprintf("nepse %s: %s (%v)", e.Type, e.Message, e.Err)
+		msg += fmt.Sprintf(" (%v)", e.Err)
+	}
+	return msg
+}
+
+// withRequestContext records the request an error belongs to. Errors that are not
+// a *NepseError are wrapped as internal errors so the context is never lost.
+func withRequestContext(err error, method, endpoint string, start time.Time) error {
+	var nepseErr *NepseError
+	if !errors.As(err, &nepseErr) {
+		nepseErr = NewInternalError("request failed", err)
+		err = nepseErr
+	}
+	if nepseErr.Endpoint == "" {
+		nepseErr.Endpoint, _, _ = strings.Cut(endpoint, "?")
+	}
+	if nepseErr.Method == "" {
+		nepseErr.Method = method
 	}
-	return fmt.Sprintf("nepse %s: %s", e.Type, e.Message)
+	nepseErr.Elapsed = time.Since(start)
+	return err
 }
 
 // Unwrap returns the underlying error
@@ -39,18 +128,31 @@ func (e *NepseError) Unwrap() error {
 	return e.Err
 }
 
-// Is checks if the error is of a specific type
+// Is reports whether target is the sentinel of e's code, or a *NepseError with the same
+// code. Invalid argument errors also match ErrInvalidClientRequest.
 func (e *NepseError) Is(target error) bool {
-	if target, ok := target.(*NepseError); ok {
-		return e.Type == target.Type
+	if t, ok := target.(*NepseError); ok {
+		return e.Code == t.Code
 	}
-	return false
+	if e.Code == ErrorTypeInvalidArgument && target == ErrInvalidClientRequest {
+		return true
+	}
+	return target != nil && sentinels[e.Code] == target
+}
+
+// CodeOf returns the ErrorCode of err, or "" if err is not a *NepseError
+func CodeOf(err error) ErrorCode {
+	var nepseErr *NepseError
+	if errors.As(err, &nepseErr) {
+		return nepseErr.Code
+	}
+	return ""
 }
 
 // NewNepseError creates a new NEPSE error
-func NewNepseError(errorType ErrorType, message string, err error) *NepseError {
+func NewNepseError(code ErrorCode, message string, err error) *NepseError {
 	return &NepseError{
-		Type:    errorType,
+		Code:    code,
 		Message: message,
 		Err:     err,
 	}
@@ -61,6 +163,11 @@ func NewInvalidClientRequestError(message string) *NepseError {
 	return NewNepseError(ErrorTypeInvalidClientRequest, message, nil)
 }
 
+// NewInvalidArgumentError creates an invalid argument error
+func NewInvalidArgumentError(message string) *NepseError {
+	return NewNepseError(ErrorTypeInvalidArgument, message, nil)
+}
+
 // NewInvalidServerResponseError creates an invalid server response error
 func NewInvalidServerResponseError(message string) *NepseError {
 	return NewNepseError(ErrorTypeInvalidServerResponse, message, nil)
@@ -96,8 +203,15 @@ func NewInternalError(message string, err error) *NepseError {
 	return NewNepseError(ErrorTypeInternal, message, err)
 }
 
-// MapHTTPStatusToError maps HTTP status codes to NEPSE errors
+// MapHTTPStatusToError maps HTTP status codes to NEPSE errors, recording the status
 func MapHTTPStatusToError(statusCode int, message string) *NepseError {
+	err := mapHTTPStatus(statusCode, message)
+	err.HTTPStatus = statusCode
+	return err
+}
+
+// mapHTTPStatus picks the error for an HTTP status code
+func mapHTTPStatus(statusCode int, message string) *NepseError {
 	switch statusCode {
 	case http.StatusBadRequest:
 		return NewInvalidClientRequestError(message)
@@ -123,9 +237,36 @@ func MapHTTPStatusToError(statusCode int, message string) *NepseError {
 	}
 }
 
+// errorFromResponse maps a failed HTTP response to a NepseError carrying the
+// request path and any Retry-After delay
+func errorFromResponse(resp *http.Response) *NepseError {
+	err := MapHTTPStatusToError(resp.StatusCode, resp.Status)
+	if resp.Request != nil && resp.Request.URL != nil {
+		err.Endpoint = resp.Request.URL.Path
+	}
+	err.RetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+	return err
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
 // IsRetryable returns true if the error is potentially retryable
 func (e *NepseError) IsRetryable() bool {
-	switch e.Type {
+	switch e.Code {
 	case ErrorTypeTokenExpired, ErrorTypeNetworkError, ErrorTypeInvalidServerResponse:
 		return true
 	case ErrorTypeRateLimit:
@@ -0,0 +1,184 @@
+package nepse
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// fallbackQuoteAge is how long quotes from today's prices are reused for
+// subscribed securities missing from the live market
+const fallbackQuoteAge = 5 * time.Minute
+
+// quoteHub polls the quotes of every SubscribeQuote subscription of a client in
+// one batch: a single live market request per tick, completed from a shared
+// today's prices snapshot for securities not trading, so the request rate does
+// not grow with the number of subscribed symbols. The loop runs at the shortest
+// subscribed interval while there are subscribers.
+type quoteHub struct {
+	mu       sync.Mutex
+	subs     map[*quoteSub]struct{}
+	interval time.Duration
+	cancel   context.CancelFunc
+
+	fallback   map[string]Quote
+	fallbackAt time.Time
+}
+
+// quoteSub is one SubscribeQuote subscription. The hub hands it quotes through
+// latest, which only keeps the newest, so a slow receiver never stalls the
+// other subscribers.
+type quoteSub struct {
+	security Security
+	interval time.Duration
+	next     time.Time
+	latest   *outbox[Quote]
+}
+
+// add registers sub and starts or speeds up the polling loop if needed
+func (q *quoteHub) add(h *HTTPClient, sub *quoteSub) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.subs == nil {
+		q.subs = make(map[*quoteSub]struct{})
+	}
+	q.subs[sub] = struct{}{}
+	q.restartLocked(h)
+}
+
+// remove unregisters sub and stops or slows down the loop if needed
+func (q *quoteHub) remove(h *HTTPClient, sub *quoteSub) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.subs, sub)
+	q.restartLocked(h)
+}
+
+// restartLocked (re)starts the loop at the shortest subscribed interval, or
+// stops it if nothing is subscribed
+func (q *quoteHub) restartLocked(h *HTTPClient) {
+	if len(q.subs) == 0 {
+		if q.cancel != nil {
+			q.cancel()
+			q.cancel, q.interval = nil, 0
+		}
+		return
+	}
+	var interval time.Duration
+	for sub := range q.subs {
+		if interval == 0 || sub.interval < interval {
+			interval = sub.interval
+		}
+	}
+	if q.cancel != nil && interval == q.interval {
+		return
+	}
+	if q.cancel != nil {
+		q.cancel()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	q.cancel, q.interval = cancel, interval
+	go q.run(ctx, h, interval)
+}
+
+// run polls quotes every interval until ctx is done and hands them out
+func (q *quoteHub) run(ctx context.Context, h *HTTPClient, interval time.Duration) {
+	feed := Feed{Kind: FeedQuote, Interval: interval}
+	fetch := func(ctx context.Context) (map[string]Quote, error) {
+		return q.fetch(ctx, h)
+	}
+	streamFeed(ctx, h.streamTransport(), feed, fetch, func(ctx context.Context, quotes map[string]Quote, err error) error {
+		if err != nil {
+			if ctx.Err() == nil {
+				h.logger.Debug("quote poll failed", "error", err)
+			}
+			return err
+		}
+		q.deliver(ctx, quotes, interval)
+		return nil
+	})
+}
+
+// deliver hands each due subscriber the quote of its security
+func (q *quoteHub) deliver(ctx context.Context, quotes map[string]Quote, tick time.Duration) {
+	now := time.Now()
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for sub := range q.subs {
+		quote, ok := quotes[sub.security.Symbol]
+		if !ok || now.Before(sub.next) {
+			continue
+		}
+		// Half a tick of slack keeps jitter from skipping a due subscriber
+		sub.next = now.Add(sub.interval - tick/2)
+		sub.latest.send(ctx, quote)
+	}
+}
+
+// fetch returns the current quotes of the subscribed securities, keyed by symbol
+func (q *quoteHub) fetch(ctx context.Context, h *HTTPClient) (map[string]Quote, error) {
+	q.mu.Lock()
+	ids := make(map[string]int32, len(q.subs))
+	for sub := range q.subs {
+		ids[sub.security.Symbol] = sub.security.ID
+	}
+	q.mu.Unlock()
+
+	live, err := h.GetLiveMarket(ctx)
+	if err != nil {
+		return nil, err
+	}
+	quotes := make(map[string]Quote, len(ids))
+	now := Timestamp{Time: time.Now().In(NepalLocation)}
+	for i := range live {
+		id, ok := ids[live[i].Symbol]
+		if !ok {
+			continue
+		}
+		quote := live[i].Quote()
+		quote.SecurityID = id
+		quote.AsOf = now
+		quotes[quote.Symbol] = quote
+	}
+	if len(quotes) == len(ids) {
+		return quotes, nil
+	}
+
+	fallback, err := q.fallbackQuotes(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	for symbol := range ids {
+		if _, ok := quotes[symbol]; ok {
+			continue
+		}
+		if quote, ok := fallback[symbol]; ok {
+			quotes[symbol] = quote
+		}
+	}
+	return quotes, nil
+}
+
+// fallbackQuotes returns quotes from today's prices, fetched at most every
+// fallbackQuoteAge
+func (q *quoteHub) fallbackQuotes(ctx context.Context, h *HTTPClient) (map[string]Quote, error) {
+	q.mu.Lock()
+	fallback, at := q.fallback, q.fallbackAt
+	q.mu.Unlock()
+	if fallback != nil && time.Since(at) < fallbackQuoteAge {
+		return fallback, nil
+	}
+
+	prices, err := h.GetTodaysPrices(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	fallback = make(map[string]Quote, len(prices))
+	for i := range prices {
+		fallback[prices[i].Symbol] = prices[i].Quote()
+	}
+	q.mu.Lock()
+	q.fallback, q.fallbackAt = fallback, time.Now()
+	q.mu.Unlock()
+	return fallback, nil
+}
@@ -0,0 +1,186 @@
+package nepse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// defaultTailInterval is how often TailFloorSheet polls unless TailInterval is given
+const defaultTailInterval = 10 * time.Second
+
+// tailPageSize is the page size TailFloorSheet requests
+const tailPageSize = 500
+
+// TailOption configures TailFloorSheet
+type TailOption func(*tailSpec)
+
+type tailSpec struct {
+	interval   time.Duration
+	after      int64
+	checkpoint *tailCheckpoint
+}
+
+// tailCheckpoint is where a tail's position is stored
+type tailCheckpoint struct {
+	store Store
+	key   string
+}
+
+// TailInterval sets how often the floor sheet is polled (10 seconds by default)
+func TailInterval(interval time.Duration) TailOption {
+	return func(s *tailSpec) {
+		s.interval = interval
+	}
+}
+
+// TailAfter resumes a tail after the given contract ID, e.g. the LastContractID
+// of the last batch processed before a restart
+func TailAfter(contractID int64) TailOption {
+	return func(s *tailSpec) {
+		s.after = contractID
+	}
+}
+
+// TailCheckpoint resumes the tail from the last contract ID committed under key
+// in store, and makes FloorSheetTrades.Commit save to it. With nothing stored
+// yet the tail starts as without it. TailAfter takes precedence.
+func TailCheckpoint(store Store, key string) TailOption {
+	return func(s *tailSpec) {
+		s.checkpoint = &tailCheckpoint{store: store, key: key}
+	}
+}
+
+// FloorSheetTrades is one batch of new trades from TailFloorSheet, or the error
+// a poll failed with. LastContractID is the highest contract ID seen so far.
+type FloorSheetTrades struct {
+	Trades         []FloorSheetEntry
+	LastContractID int64
+	Time           time.Time
+	Err            error
+
+	checkpoint *tailCheckpoint
+}
+
+// Commit saves LastContractID to the tail's TailCheckpoint store. Call it once
+// the batch is processed, so a restarted tail neither skips nor repeats trades.
+// Without TailCheckpoint it does nothing.
+func (b *FloorSheetTrades) Commit(ctx context.Context) error {
+	if b.checkpoint == nil || b.LastContractID == 0 {
+		return nil
+	}
+	value := strconv.FormatInt(b.LastContractID, 10)
+	if err := b.checkpoint.store.Save(ctx, b.checkpoint.key, []byte(value)); err != nil {
+		return fmt.Errorf("failed to commit floor sheet position: %w", err)
+	}
+	return nil
+}
+
+// TailFloorSheet turns the floor sheet of a business date (empty for the latest
+// session) into a trade stream. Each poll reads pages newest first until it
+// reaches a contract already seen, and sends the new trades oldest first. Without
+// TailAfter the first batch holds every trade of the session so far.
+//
+//	trades, err := client.TailFloorSheet(ctx, "", nepse.TailInterval(5*time.Second))
+//	for batch := range trades {
+//		if batch.Err == nil {
+//			archive(batch.Trades)
+//		}
+//	}
+//
+// Archivers that must survive restarts pass TailCheckpoint and Commit each
+// batch after archiving it.
+func (h *HTTPClient) TailFloorSheet(ctx context.Context, businessDate string, opts ...TailOption) (<-chan FloorSheetTrades, error) {
+	spec := tailSpec{interval: defaultTailInterval}
+	for _, opt := range opts {
+		opt(&spec)
+	}
+	if err := validateInterval(spec.interval); err != nil {
+		return nil, err
+	}
+	businessDate, err := h.requestDate(businessDate)
+	if err != nil {
+		return nil, err
+	}
+	if spec.checkpoint != nil && spec.after == 0 {
+		if spec.after, err = spec.checkpoint.load(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	batches := newOutbox(h, mergeFloorSheetTrades)
+	go func() {
+		defer batches.close()
+		last := spec.after
+		feed := Feed{Kind: FeedFloorSheet, Key: businessDate, Interval: spec.interval}
+		fetch := func(ctx context.Context) ([]FloorSheetEntry, error) {
+			return h.floorSheetAfter(ctx, businessDate, last)
+		}
+		streamFeed(ctx, h.streamTransport(), feed, fetch, func(ctx context.Context, trades []FloorSheetEntry, err error) error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err != nil {
+				batches.send(ctx, FloorSheetTrades{LastContractID: last, Time: time.Now(), Err: err, checkpoint: spec.checkpoint})
+				return err
+			}
+			if len(trades) == 0 {
+				return nil
+			}
+			newLast := trades[len(trades)-1].ContractID
+			if batches.send(ctx, FloorSheetTrades{Trades: trades, LastContractID: newLast, Time: time.Now(), checkpoint: spec.checkpoint}) {
+				last = newLast
+			}
+			return nil
+		})
+	}()
+	return batches.ch, nil
+}
+
+// load returns the stored contract ID, or zero if none was committed yet
+func (c *tailCheckpoint) load(ctx context.Context) (int64, error) {
+	value, err := c.store.Load(ctx, c.key)
+	if errors.Is(err, ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to load floor sheet position: %w", err)
+	}
+	after, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		return 0, NewInternalError(fmt.Sprintf("invalid floor sheet position %q under %q", value, c.key), err)
+	}
+	return after, nil
+}
+
+// floorSheetAfter returns the trades of an AD business date with a contract ID
+// above after, in ascending contract order
+func (h *HTTPClient) floorSheetAfter(ctx context.Context, businessDate string, after int64) ([]FloorSheetEntry, error) {
+	var trades []FloorSheetEntry
+	for page := 0; ; page++ {
+		sheet, err := h.floorSheetPage(ctx, businessDate, page, tailPageSize)
+		if err != nil {
+			return nil, err
+		}
+		reached := false
+		for _, entry := range sheet.Content {
+			if entry.ContractID <= after {
+				reached = true
+				break
+			}
+			// Trades arriving mid-walk shift rows onto the next page again
+			if n := len(trades); n > 0 && entry.ContractID >= trades[n-1].ContractID {
+				continue
+			}
+			trades = append(trades, entry)
+		}
+		if reached || !sheet.HasNext() {
+			break
+		}
+	}
+	slices.Reverse(trades)
+	return trades, nil
+}
@@ -0,0 +1,70 @@
+package nepse
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Forward performs an authenticated request to a NEPSE API path, such as
+// "/api/nots/nepse-data/today-price?size=500", and returns the raw response
+// body. It goes through the client's rate limiter, retries and token refresh,
+// so many callers can share one well-behaved connection to NEPSE. POSTs to
+// the endpoints that expect a payload ID (index and security graphs and the
+// floor sheet) are sent the computed ID, whatever body is given. Responses
+// other than 200 are returned as errors.
+func (h *HTTPClient) Forward(ctx context.Context, method, path string, body []byte) ([]byte, error) {
+	if method != http.MethodGet && method != http.MethodPost {
+		return nil, NewInvalidArgumentError(fmt.Sprintf("unsupported method %s", method))
+	}
+	if !strings.HasPrefix(path, "/api/") {
+		return nil, NewInvalidArgumentError(fmt.Sprintf("path %q is not a NEPSE API path", path))
+	}
+	var build requestBody
+	if method == http.MethodPost {
+		if kind, ok := h.payloadKindOf(path); ok {
+			build = h.payloadBody(kind)
+		} else if body == nil {
+			build = staticBody([]byte("{}"))
+		} else {
+			build = staticBody(body)
+		}
+	}
+
+	start := time.Now()
+	resp, err := h.apiDo(ctx, method, path, build, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	reader, err := h.getResponseBody(resp)
+	if err != nil {
+		return nil, withRequestContext(NewInternalError("failed to read response body", err), method, path, start)
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, withRequestContext(NewNetworkError(fmt.Errorf("failed to read response body: %w", err)), method, path, start)
+	}
+	return data, nil
+}
+
+// payloadKindOf returns the payload ID variant a POST to path expects, if any
+func (h *HTTPClient) payloadKindOf(path string) (payloadKind, bool) {
+	path, _, _ = strings.Cut(path, "?")
+	if path == h.endpoint(EndpointFloorSheet) {
+		return payloadFloorSheet, true
+	}
+	if prefix := h.endpoint(EndpointCompanyDailyGraph); strings.HasPrefix(path, prefix) && len(path) > len(prefix) {
+		return payloadScrips, true
+	}
+	for _, endpoint := range indexGraphEndpoints {
+		if path == h.endpoint(endpoint) {
+			return payloadGeneral, true
+		}
+	}
+	return 0, false
+}
@@ -1,211 +1,293 @@
 package nepse
 
 import (
-    "context"
-    "fmt"
+	"context"
+	"fmt"
+	"net/url"
 )
 
-// Graph Data GET API Methods (aligned with Client interface)
+// Graph Data API Methods (aligned with Client interface)
+//
+// NEPSE only serves graph data over POST, with a payload ID derived from the
+// market status and token salts (see payload.go).
 
 // Index Graph Methods
 func (h *HTTPClient) GetDailyNepseIndexGraph(ctx context.Context) (*GraphResponse, error) {
-    var arr []GraphDataPoint
-    if err := h.apiRequest(ctx, h.config.APIEndpoints["nepse_index_daily_graph"], &arr); err != nil {
-        return nil, fmt.Errorf("failed to get daily NEPSE index graph: %w", err)
-    }
-    return &GraphResponse{Data: arr}, nil
+	g, err := h.getGraph(ctx, h.endpoint(indexGraphEndpoints[IndexNepse]), payloadGeneral, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily NEPSE index graph: %w", err)
+	}
+	return g, nil
 }
 
 func (h *HTTPClient) GetDailySensitiveIndexGraph(ctx context.Context) (*GraphResponse, error) {
-    var arr []GraphDataPoint
-    if err := h.apiRequest(ctx, h.config.APIEndpoints["sensitive_index_daily_graph"], &arr); err != nil {
-        return nil, fmt.Errorf("failed to get daily sensitive index graph: %w", err)
-    }
-    return &GraphResponse{Data: arr}, nil
+	g, err := h.getGraph(ctx, h.endpoint(indexGraphEndpoints[IndexSensitive]), payloadGeneral, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily sensitive index graph: %w", err)
+	}
+	return g, nil
 }
 
 func (h *HTTPClient) GetDailyFloatIndexGraph(ctx context.Context) (*GraphResponse, error) {
-    var arr []GraphDataPoint
-    if err := h.apiRequest(ctx, h.config.APIEndpoints["float_index_daily_graph"], &arr); err != nil {
-        return nil, fmt.Errorf("failed to get daily float index graph: %w", err)
-    }
-    return &GraphResponse{Data: arr}, nil
+	g, err := h.getGraph(ctx, h.endpoint(indexGraphEndpoints[IndexFloat]), payloadGeneral, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily float index graph: %w", err)
+	}
+	return g, nil
 }
 
 func (h *HTTPClient) GetDailySensitiveFloatIndexGraph(ctx context.Context) (*GraphResponse, error) {
-    var arr []GraphDataPoint
-    if err := h.apiRequest(ctx, h.config.APIEndpoints["sensitive_float_index_daily_graph"], &arr); err != nil {
-        return nil, fmt.Errorf("failed to get daily sensitive float index graph: %w", err)
-    }
-    return &GraphResponse{Data: arr}, nil
+	g, err := h.getGraph(ctx, h.endpoint(indexGraphEndpoints[IndexSensitiveFloat]), payloadGeneral, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily sensitive float index graph: %w", err)
+	}
+	return g, nil
 }
 
 // Sector Sub-Index Graph Methods
 func (h *HTTPClient) GetDailyBankSubindexGraph(ctx context.Context) (*GraphResponse, error) {
-    var arr []GraphDataPoint
-    if err := h.apiRequest(ctx, h.config.APIEndpoints["banking_sub_index_graph"], &arr); err != nil {
-        return nil, fmt.Errorf("failed to get daily banking sub-index graph: %w", err)
-    }
-    return &GraphResponse{Data: arr}, nil
+	g, err := h.getGraph(ctx, h.endpoint(indexGraphEndpoints[IndexBanking]), payloadGeneral, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily banking sub-index graph: %w", err)
+	}
+	return g, nil
 }
 
 func (h *HTTPClient) GetDailyDevelopmentBankSubindexGraph(ctx context.Context) (*GraphResponse, error) {
-    var arr []GraphDataPoint
-    if err := h.apiRequest(ctx, h.config.APIEndpoints["development_bank_sub_index_graph"], &arr); err != nil {
-        return nil, fmt.Errorf("failed to get daily development bank sub-index graph: %w", err)
-    }
-    return &GraphResponse{Data: arr}, nil
+	g, err := h.getGraph(ctx, h.endpoint(indexGraphEndpoints[IndexDevelopmentBank]), payloadGeneral, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily development bank sub-index graph: %w", err)
+	}
+	return g, nil
 }
 
 func (h *HTTPClient) GetDailyFinanceSubindexGraph(ctx context.Context) (*GraphResponse, error) {
-    var arr []GraphDataPoint
-    if err := h.apiRequest(ctx, h.config.APIEndpoints["finance_sub_index_graph"], &arr); err != nil {
-        return nil, fmt.Errorf("failed to get daily finance sub-index graph: %w", err)
-    }
-    return &GraphResponse{Data: arr}, nil
+	g, err := h.getGraph(ctx, h.endpoint(indexGraphEndpoints[IndexFinance]), payloadGeneral, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily finance sub-index graph: %w", err)
+	}
+	return g, nil
 }
 
 func (h *HTTPClient) GetDailyHotelTourismSubindexGraph(ctx context.Context) (*GraphResponse, error) {
-    var arr []GraphDataPoint
-    if err := h.apiRequest(ctx, h.config.APIEndpoints["hotel_tourism_sub_index_graph"], &arr); err != nil {
-        return nil, fmt.Errorf("failed to get daily hotel tourism sub-index graph: %w", err)
-    }
-    return &GraphResponse{Data: arr}, nil
+	g, err := h.getGraph(ctx, h.endpoint(indexGraphEndpoints[IndexHotelTourism]), payloadGeneral, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily hotel tourism sub-index graph: %w", err)
+	}
+	return g, nil
 }
 
 func (h *HTTPClient) GetDailyHydroSubindexGraph(ctx context.Context) (*GraphResponse, error) {
-    var arr []GraphDataPoint
-    if err := h.apiRequest(ctx, h.config.APIEndpoints["hydro_sub_index_graph"], &arr); err != nil {
-        return nil, fmt.Errorf("failed to get daily hydro sub-index graph: %w", err)
-    }
-    return &GraphResponse{Data: arr}, nil
+	g, err := h.getGraph(ctx, h.endpoint(indexGraphEndpoints[IndexHydro]), payloadGeneral, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily hydro sub-index graph: %w", err)
+	}
+	return g, nil
 }
 
 func (h *HTTPClient) GetDailyInvestmentSubindexGraph(ctx context.Context) (*GraphResponse, error) {
-    var arr []GraphDataPoint
-    if err := h.apiRequest(ctx, h.config.APIEndpoints["investment_sub_index_graph"], &arr); err != nil {
-        return nil, fmt.Errorf("failed to get daily investment sub-index graph: %w", err)
-    }
-    return &GraphResponse{Data: arr}, nil
+	g, err := h.getGraph(ctx, h.endpoint(indexGraphEndpoints[IndexInvestment]), payloadGeneral, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily investment sub-index graph: %w", err)
+	}
+	return g, nil
 }
 
 func (h *HTTPClient) GetDailyLifeInsuranceSubindexGraph(ctx context.Context) (*GraphResponse, error) {
-    var arr []GraphDataPoint
-    if err := h.apiRequest(ctx, h.config.APIEndpoints["life_insurance_sub_index_graph"], &arr); err != nil {
-        return nil, fmt.Errorf("failed to get daily life insurance sub-index graph: %w", err)
-    }
-    return &GraphResponse{Data: arr}, nil
+	g, err := h.getGraph(ctx, h.endpoint(indexGraphEndpoints[IndexLifeInsurance]), payloadGeneral, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily life insurance sub-index graph: %w", err)
+	}
+	return g, nil
 }
 
 func (h *HTTPClient) GetDailyManufacturingSubindexGraph(ctx context.Context) (*GraphResponse, error) {
-    var arr []GraphDataPoint
-    if err := h.apiRequest(ctx, h.config.APIEndpoints["manufacturing_sub_index_graph"], &arr); err != nil {
-        return nil, fmt.Errorf("failed to get daily manufacturing sub-index graph: %w", err)
-    }
-    return &GraphResponse{Data: arr}, nil
+	g, err := h.getGraph(ctx, h.endpoint(indexGraphEndpoints[IndexManufacturing]), payloadGeneral, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily manufacturing sub-index graph: %w", err)
+	}
+	return g, nil
 }
 
 func (h *HTTPClient) GetDailyMicrofinanceSubindexGraph(ctx context.Context) (*GraphResponse, error) {
-    var arr []GraphDataPoint
-    if err := h.apiRequest(ctx, h.config.APIEndpoints["microfinance_sub_index_graph"], &arr); err != nil {
-        return nil, fmt.Errorf("failed to get daily microfinance sub-index graph: %w", err)
-    }
-    return &GraphResponse{Data: arr}, nil
+	g, err := h.getGraph(ctx, h.endpoint(indexGraphEndpoints[IndexMicrofinance]), payloadGeneral, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily microfinance sub-index graph: %w", err)
+	}
+	return g, nil
 }
 
 func (h *HTTPClient) GetDailyMutualfundSubindexGraph(ctx context.Context) (*GraphResponse, error) {
-    var arr []GraphDataPoint
-    if err := h.apiRequest(ctx, h.config.APIEndpoints["mutual_fund_sub_index_graph"], &arr); err != nil {
-        return nil, fmt.Errorf("failed to get daily mutual fund sub-index graph: %w", err)
-    }
-    return &GraphResponse{Data: arr}, nil
+	g, err := h.getGraph(ctx, h.endpoint(indexGraphEndpoints[IndexMutualFund]), payloadGeneral, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily mutual fund sub-index graph: %w", err)
+	}
+	return g, nil
 }
 
 func (h *HTTPClient) GetDailyNonLifeInsuranceSubindexGraph(ctx context.Context) (*GraphResponse, error) {
-    var arr []GraphDataPoint
-    if err := h.apiRequest(ctx, h.config.APIEndpoints["non_life_insurance_sub_index_graph"], &arr); err != nil {
-        return nil, fmt.Errorf("failed to get daily non-life insurance sub-index graph: %w", err)
-    }
-    return &GraphResponse{Data: arr}, nil
+	g, err := h.getGraph(ctx, h.endpoint(indexGraphEndpoints[IndexNonLifeInsurance]), payloadGeneral, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily non-life insurance sub-index graph: %w", err)
+	}
+	return g, nil
 }
 
 func (h *HTTPClient) GetDailyOthersSubindexGraph(ctx context.Context) (*GraphResponse, error) {
-    var arr []GraphDataPoint
-    if err := h.apiRequest(ctx, h.config.APIEndpoints["others_sub_index_graph"], &arr); err != nil {
-        return nil, fmt.Errorf("failed to get daily others sub-index graph: %w", err)
-    }
-    return &GraphResponse{Data: arr}, nil
+	g, err := h.getGraph(ctx, h.endpoint(indexGraphEndpoints[IndexOthers]), payloadGeneral, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily others sub-index graph: %w", err)
+	}
+	return g, nil
 }
 
 func (h *HTTPClient) GetDailyTradingSubindexGraph(ctx context.Context) (*GraphResponse, error) {
-    var arr []GraphDataPoint
-    if err := h.apiRequest(ctx, h.config.APIEndpoints["trading_sub_index_graph"], &arr); err != nil {
-        return nil, fmt.Errorf("failed to get daily trading sub-index graph: %w", err)
-    }
-    return &GraphResponse{Data: arr}, nil
+	g, err := h.getGraph(ctx, h.endpoint(indexGraphEndpoints[IndexTrading]), payloadGeneral, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily trading sub-index graph: %w", err)
+	}
+	return g, nil
 }
 
 // Company-Specific Graph
 func (h *HTTPClient) GetDailyScripPriceGraph(ctx context.Context, securityID int32) (*GraphResponse, error) {
-    endpoint := fmt.Sprintf("%s%d", h.config.APIEndpoints["company_daily_graph"], securityID)
-    var arr []GraphDataPoint
-    if err := h.apiRequest(ctx, endpoint, &arr); err != nil {
-        return nil, fmt.Errorf("failed to get daily scrip price graph for security %d: %w", securityID, err)
-    }
-    return &GraphResponse{Data: arr}, nil
+	return h.GetScripPriceGraph(ctx, securityID, nil)
 }
 
 func (h *HTTPClient) GetDailyScripPriceGraphBySymbol(ctx context.Context, symbol string) (*GraphResponse, error) {
-    security, err := h.findSecurityBySymbol(ctx, symbol)
-    if err != nil {
-        return nil, fmt.Errorf("failed to find security %s: %w", symbol, err)
-    }
-    return h.GetDailyScripPriceGraph(ctx, security.ID)
+	security, err := h.findSecurityBySymbol(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find security %s: %w", symbol, err)
+	}
+	return h.GetDailyScripPriceGraph(ctx, security.ID)
 }
 
 // Batch helpers
 func (h *HTTPClient) GetAllSubIndexGraphs(ctx context.Context) (map[string]*GraphResponse, error) {
-    subIndex := map[string]func(context.Context) (*GraphResponse, error){
-        "banking":            h.GetDailyBankSubindexGraph,
-        "development_bank":   h.GetDailyDevelopmentBankSubindexGraph,
-        "finance":            h.GetDailyFinanceSubindexGraph,
-        "hotel_tourism":      h.GetDailyHotelTourismSubindexGraph,
-        "hydro":              h.GetDailyHydroSubindexGraph,
-        "investment":         h.GetDailyInvestmentSubindexGraph,
-        "life_insurance":     h.GetDailyLifeInsuranceSubindexGraph,
-        "manufacturing":      h.GetDailyManufacturingSubindexGraph,
-        "microfinance":       h.GetDailyMicrofinanceSubindexGraph,
-        "mutual_fund":        h.GetDailyMutualfundSubindexGraph,
-        "non_life_insurance": h.GetDailyNonLifeInsuranceSubindexGraph,
-        "others":             h.GetDailyOthersSubindexGraph,
-        "trading":            h.GetDailyTradingSubindexGraph,
-    }
-    out := make(map[string]*GraphResponse)
-    for name, fn := range subIndex {
-        g, err := fn(ctx)
-        if err != nil {
-            return nil, fmt.Errorf("failed to get %s sub-index graph: %w", name, err)
-        }
-        out[name] = g
-    }
-    return out, nil
+	subIndex := map[string]func(context.Context) (*GraphResponse, error){
+		"banking":            h.GetDailyBankSubindexGraph,
+		"development_bank":   h.GetDailyDevelopmentBankSubindexGraph,
+		"finance":            h.GetDailyFinanceSubindexGraph,
+		"hotel_tourism":      h.GetDailyHotelTourismSubindexGraph,
+		"hydro":              h.GetDailyHydroSubindexGraph,
+		"investment":         h.GetDailyInvestmentSubindexGraph,
+		"life_insurance":     h.GetDailyLifeInsuranceSubindexGraph,
+		"manufacturing":      h.GetDailyManufacturingSubindexGraph,
+		"microfinance":       h.GetDailyMicrofinanceSubindexGraph,
+		"mutual_fund":        h.GetDailyMutualfundSubindexGraph,
+		"non_life_insurance": h.GetDailyNonLifeInsuranceSubindexGraph,
+		"others":             h.GetDailyOthersSubindexGraph,
+		"trading":            h.GetDailyTradingSubindexGraph,
+	}
+	out := make(map[string]*GraphResponse)
+	for name, fn := range subIndex {
+		g, err := fn(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s sub-index graph: %w", name, err)
+		}
+		out[name] = g
+	}
+	return out, nil
 }
 
 func (h *HTTPClient) GetAllMainIndexGraphs(ctx context.Context) (map[string]*GraphResponse, error) {
-    main := map[string]func(context.Context) (*GraphResponse, error){
-        "nepse":           h.GetDailyNepseIndexGraph,
-        "sensitive":       h.GetDailySensitiveIndexGraph,
-        "float":           h.GetDailyFloatIndexGraph,
-        "sensitive_float": h.GetDailySensitiveFloatIndexGraph,
-    }
-    out := make(map[string]*GraphResponse)
-    for name, fn := range main {
-        g, err := fn(ctx)
-        if err != nil {
-            return nil, fmt.Errorf("failed to get %s index graph: %w", name, err)
-        }
-        out[name] = g
-    }
-    return out, nil
+	main := map[string]func(context.Context) (*GraphResponse, error){
+		"nepse":           h.GetDailyNepseIndexGraph,
+		"sensitive":       h.GetDailySensitiveIndexGraph,
+		"float":           h.GetDailyFloatIndexGraph,
+		"sensitive_float": h.GetDailySensitiveFloatIndexGraph,
+	}
+	out := make(map[string]*GraphResponse)
+	for name, fn := range main {
+		g, err := fn(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s index graph: %w", name, err)
+		}
+		out[name] = g
+	}
+	return out, nil
 }
 
+// indexGraphEndpoints maps index IDs to their graph endpoints
+var indexGraphEndpoints = map[int32]Endpoint{
+	IndexNepse:            EndpointNepseIndexDailyGraph,
+	IndexSensitive:        EndpointSensitiveIndexDailyGraph,
+	IndexFloat:            EndpointFloatIndexDailyGraph,
+	IndexSensitiveFloat:   EndpointSensitiveFloatIndexDailyGraph,
+	IndexBanking:          EndpointBankingSubIndexGraph,
+	IndexDevelopmentBank:  EndpointDevelopmentBankSubIndexGraph,
+	IndexFinance:          EndpointFinanceSubIndexGraph,
+	IndexHotelTourism:     EndpointHotelTourismSubIndexGraph,
+	IndexHydro:            EndpointHydroSubIndexGraph,
+	IndexInvestment:       EndpointInvestmentSubIndexGraph,
+	IndexLifeInsurance:    EndpointLifeInsuranceSubIndexGraph,
+	IndexManufacturing:    EndpointManufacturingSubIndexGraph,
+	IndexMicrofinance:     EndpointMicrofinanceSubIndexGraph,
+	IndexMutualFund:       EndpointMutualFundSubIndexGraph,
+	IndexNonLifeInsurance: EndpointNonLifeInsuranceSubIndexGraph,
+	IndexOthers:           EndpointOthersSubIndexGraph,
+	IndexTrading:          EndpointTradingSubIndexGraph,
+}
+
+// GetIndexGraph retrieves the intraday graph of any index (e.g. IndexNepse).
+// opts may select a previous business date or a time window; nil means today.
+func (h *HTTPClient) GetIndexGraph(ctx context.Context, indexID int32, opts *GraphOptions) (*GraphResponse, error) {
+	endpoint, ok := indexGraphEndpoints[indexID]
+	if !ok {
+		return nil, NewInvalidClientRequestError(fmt.Sprintf("unknown index ID %d", indexID))
+	}
+	g, err := h.getGraph(ctx, h.endpoint(endpoint), payloadGeneral, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get graph for index %d: %w", indexID, err)
+	}
+	return g, nil
+}
+
+// GetScripPriceGraph retrieves the intraday price graph of a security.
+// opts may select a previous business date or a time window; nil means today.
+func (h *HTTPClient) GetScripPriceGraph(ctx context.Context, securityID int32, opts *GraphOptions) (*GraphResponse, error) {
+	if err := validateID("security ID", securityID); err != nil {
+		return nil, err
+	}
+	endpoint := fmt.Sprintf("%s%d", h.endpoint(EndpointCompanyDailyGraph), securityID)
+	g, err := h.getGraph(ctx, endpoint, payloadScrips, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scrip price graph for security %d: %w", securityID, err)
+	}
+	return g, nil
+}
+
+// getGraph fetches graph points, applying the business date and time window from opts
+func (h *HTTPClient) getGraph(ctx context.Context, endpoint string, kind payloadKind, opts *GraphOptions) (*GraphResponse, error) {
+	if opts != nil && opts.BusinessDate != "" {
+		businessDate, err := h.requestDate(opts.BusinessDate)
+		if err != nil {
+			return nil, err
+		}
+		endpoint = withQuery(endpoint, url.Values{"businessDate": {businessDate}})
+	}
+
+	var arr []GraphDataPoint
+	if err := h.apiPostWithPayloadID(ctx, endpoint, kind, &arr); err != nil {
+		return nil, err
+	}
+
+	if opts != nil && (!opts.From.IsZero() || !opts.To.IsZero()) {
+		filtered := arr[:0]
+		for _, p := range arr {
+			t := p.Date.Time
+			if t.IsZero() {
+				continue
+			}
+			if !opts.From.IsZero() && t.Before(opts.From) {
+				continue
+			}
+			if !opts.To.IsZero() && t.After(opts.To) {
+				continue
+			}
+			filtered = append(filtered, p)
+		}
+		arr = filtered
+	}
+	return &GraphResponse{Data: arr}, nil
+}
@@ -1,11 +1,15 @@
 package nepse
 
 import (
+    "bytes"
     "context"
     "crypto/tls"
     "encoding/json"
+    "fmt"
     "io"
+    "log/slog"
     "net/http"
+    "net/url"
     "strings"
     "time"
 
@@ -18,6 +22,12 @@ type HTTPClient struct {
 	config      *Config
 	authManager *auth.Manager
 	options     *Options
+	logger      *slog.Logger
+	limiter     *rateLimiter
+	calendars   calendarCache
+	dummy       dummyIDCache
+	symbolIndex symbolIndex
+	quoteHub    quoteHub
 }
 
 // NewHTTPClient creates a new HTTP client for NEPSE API
@@ -29,12 +39,33 @@ func NewHTTPClient(options *Options) (*HTTPClient, error) {
 	if options.Config == nil {
 		options.Config = DefaultConfig()
 	}
+	if err := options.Config.Endpoints.Validate(); err != nil {
+		return nil, err
+	}
+
+	baseURL, err := resolveBaseURL(options)
+	if err != nil {
+		return nil, err
+	}
+	// Copy so the resolved base URL doesn't leak into a shared Config
+	config := *options.Config
+	config.BaseURL = baseURL
 
     // Create or use provided HTTP client
     httpClient := options.HTTPClient
     if httpClient == nil {
+        proxy := http.ProxyFromEnvironment
+        if options.Proxy != "" {
+            proxyURL, err := url.Parse(options.Proxy)
+            if err != nil {
+                return nil, NewInvalidClientRequestError(fmt.Sprintf("invalid proxy URL %q", options.Proxy))
+            }
+            proxy = http.ProxyURL(proxyURL)
+        }
+
         // Only construct transport if no client supplied
         transport := &http.Transport{
+            Proxy: proxy,
             TLSClientConfig: &tls.Config{ //nolint:gosec // user controls via TLSVerification
                 InsecureSkipVerify: !options.TLSVerification,
             },
@@ -52,10 +83,17 @@ func NewHTTPClient(options *Options) (*HTTPClient, error) {
         httpClient.Timeout = options.HTTPTimeout
     }
 
+	logger := options.Logger
+	if logger == nil {
+		logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+	}
+
 	nepseClient := &HTTPClient{
 		client:  httpClient,
-		config:  options.Config,
+		config:  &config,
 		options: options,
+		logger:  logger,
+		limiter: newRateLimiter(options.RateLimit),
 	}
 
 	// Create auth manager
@@ -86,7 +124,7 @@ func (h *HTTPClient) GetTokens(ctx context.Context) (*auth.TokenResponse, error)
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, MapHTTPStatusToError(resp.StatusCode, resp.Status)
+		return nil, errorFromResponse(resp)
 	}
 
 	body, err := h.getResponseBody(resp)
@@ -122,7 +160,7 @@ func (h *HTTPClient) RefreshTokens(ctx context.Context, refreshToken string) (*a
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, MapHTTPStatusToError(resp.StatusCode, resp.Status)
+		return nil, errorFromResponse(resp)
 	}
 
 	body, err := h.getResponseBody(resp)
@@ -139,17 +177,50 @@ func (h *HTTPClient) RefreshTokens(ctx context.Context, refreshToken string) (*a
 	return &tokenResp, nil
 }
 
-// doRequest performs HTTP request with retry logic
+// doRequest performs HTTP request with retry logic, recording it in the
+// ResponseMeta of the request context
 func (h *HTTPClient) doRequest(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, attempts, err := h.doRequestAttempts(req)
+	recordResponse(req.Context(), req, resp, attempts, time.Since(start))
+	return resp, err
+}
+
+// doRequestAttempts performs HTTP request with retry logic and returns the number
+// of attempts made
+func (h *HTTPClient) doRequestAttempts(req *http.Request) (*http.Response, int, error) {
 	var lastErr error
 
 	for attempt := 0; attempt <= h.options.MaxRetries; attempt++ {
 		if attempt > 0 {
             // Calculate backoff delay
             delay := minDuration(h.options.RetryDelay*time.Duration(1<<uint(attempt-1)), 30*time.Second)
-            time.Sleep(delay)
+            if nepseErr, ok := lastErr.(*NepseError); ok && nepseErr.RetryAfter > delay {
+                delay = minDuration(nepseErr.RetryAfter, 30*time.Second)
+            }
+            h.logger.Debug("retrying request", "url", req.URL.Path, "attempt", attempt, "delay", delay, "error", lastErr)
+            timer := time.NewTimer(delay)
+            select {
+            case <-req.Context().Done():
+                timer.Stop()
+                return nil, attempt, withAttempts(NewNetworkError(req.Context().Err()), attempt)
+            case <-timer.C:
+            }
         }
 
+		if err := h.limiter.Wait(req.Context()); err != nil {
+			return nil, attempt, withAttempts(NewNetworkError(err), attempt)
+		}
+
+		// Rewind the request body consumed by the previous attempt
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, attempt, withAttempts(NewInternalError("failed to rewind request body", err), attempt)
+			}
+			req.Body = body
+		}
+
 		resp, err := h.client.Do(req)
 		if err != nil {
 			lastErr = NewNetworkError(err)
@@ -159,17 +230,25 @@ func (h *HTTPClient) doRequest(req *http.Request) (*http.Response, error) {
 		// Check if we should retry based on status code
 		if resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests {
 			resp.Body.Close()
-			lastErr = MapHTTPStatusToError(resp.StatusCode, resp.Status)
+			lastErr = errorFromResponse(resp)
 			if !lastErr.(*NepseError).IsRetryable() {
-				return nil, lastErr
+				return nil, attempt + 1, withAttempts(lastErr, attempt+1)
 			}
 			continue
 		}
 
-		return resp, nil
+		return resp, attempt + 1, nil
 	}
 
-	return nil, lastErr
+	return nil, h.options.MaxRetries + 1, withAttempts(lastErr, h.options.MaxRetries+1)
+}
+
+// withAttempts records how many HTTP attempts were made before err
+func withAttempts(err error, attempts int) error {
+	if nepseErr, ok := err.(*NepseError); ok {
+		nepseErr.Attempts = attempts
+	}
+	return err
 }
 
 // getResponseBody handles gzip decompression
@@ -201,22 +280,99 @@ func (h *HTTPClient) setCommonHeaders(req *http.Request, _ bool) {
 	req.Header.Set("Origin", h.config.BaseURL)
 }
 
+// endpoint returns the registered path for a NEPSE API endpoint
+func (h *HTTPClient) endpoint(endpoint Endpoint) string {
+	return h.config.Endpoints.Path(endpoint)
+}
+
 // apiRequest performs an authenticated API request
 func (h *HTTPClient) apiRequest(ctx context.Context, endpoint string, result any) error {
-	return h.apiRequestWithRetry(ctx, endpoint, result, 0)
+	return h.apiRequestWithRetry(ctx, http.MethodGet, endpoint, nil, result)
+}
+
+// apiPostRequest performs an authenticated POST request with a JSON payload
+func (h *HTTPClient) apiPostRequest(ctx context.Context, endpoint string, payload any, result any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return NewInternalError("failed to encode request payload", err)
+	}
+	return h.apiRequestWithRetry(ctx, http.MethodPost, endpoint, staticBody(data), result)
+}
+
+// requestBody builds the body of each attempt of a request. Bodies derived
+// from the token salts, such as payload IDs, must be rebuilt after a token
+// refresh rotates the salts.
+type requestBody func(ctx context.Context) ([]byte, error)
+
+// staticBody returns a requestBody sending payload on every attempt
+func staticBody(payload []byte) requestBody {
+	return func(context.Context) ([]byte, error) { return payload, nil }
 }
 
 // apiRequestWithRetry performs an authenticated API request with token refresh retry
-func (h *HTTPClient) apiRequestWithRetry(ctx context.Context, endpoint string, result any, retryCount int) error {
+// and decodes the JSON response into result
+func (h *HTTPClient) apiRequestWithRetry(ctx context.Context, method, endpoint string, payload requestBody, result any) error {
+	start := time.Now()
+	resp, err := h.apiDo(ctx, method, endpoint, payload, 0)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := h.getResponseBody(resp)
+	if err != nil {
+		return withRequestContext(NewInternalError("failed to read response body", err), method, endpoint, start)
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return withRequestContext(NewNetworkError(fmt.Errorf("failed to read response body: %w", err)), method, endpoint, start)
+	}
+	if err := decodeJSON(data, result); err != nil {
+		return withRequestContext(NewInternalError("failed to decode response", err), method, endpoint, start)
+	}
+	h.checkSchema(endpoint, data, result)
+
+	return nil
+}
+
+// apiDo performs an authenticated API request, refreshing the token once if it is
+// rejected, and returns the successful response. The caller must close the body.
+// A nil body sends none. Errors carry the method, endpoint, attempt count and
+// elapsed time.
+func (h *HTTPClient) apiDo(ctx context.Context, method, endpoint string, body requestBody, retryCount int) (*http.Response, error) {
+	start := time.Now()
+	resp, err := h.apiDoOnce(ctx, method, endpoint, body, retryCount)
+	if err != nil {
+		return nil, withRequestContext(err, method, endpoint, start)
+	}
+	return resp, nil
+}
+
+// apiDoOnce performs one authenticated request, retrying once with a fresh token
+// and a rebuilt body
+func (h *HTTPClient) apiDoOnce(ctx context.Context, method, endpoint string, body requestBody, retryCount int) (*http.Response, error) {
 	token, err := h.authManager.AccessToken(ctx)
 	if err != nil {
-		return NewInternalError("failed to get access token", err)
+		return nil, NewInternalError("failed to get access token", err)
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		payload, err := body(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if payload != nil {
+			reqBody = bytes.NewReader(payload)
+		}
 	}
 
 	url := h.config.BaseURL + endpoint
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
-		return NewInternalError("failed to create request", err)
+		return nil, NewInternalError("failed to create request", err)
 	}
 
 	// Set authenticated headers
@@ -226,33 +382,31 @@ func (h *HTTPClient) apiRequestWithRetry(ctx context.Context, endpoint string, r
 
 	resp, err := h.doRequest(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	// Handle token expiration
-	if resp.StatusCode == http.StatusUnauthorized && retryCount == 0 {
+	// Handle token expiration. NEPSE answers 403 rather than 401 when tokens
+	// go stale mid-session, so both get one retry with a rotated token.
+	if isTokenRejected(resp.StatusCode) && retryCount == 0 {
+		resp.Body.Close()
+		h.logger.Debug("token rejected, forcing refresh", "endpoint", endpoint, "status", resp.StatusCode)
 		if err := h.authManager.ForceUpdate(ctx); err != nil {
-			return NewInternalError("failed to refresh token", err)
+			return nil, NewInternalError("failed to refresh token", err)
 		}
-		return h.apiRequestWithRetry(ctx, endpoint, result, retryCount+1)
+		return h.apiDoOnce(ctx, method, endpoint, body, retryCount+1)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return MapHTTPStatusToError(resp.StatusCode, resp.Status)
+		resp.Body.Close()
+		return nil, errorFromResponse(resp)
 	}
 
-	body, err := h.getResponseBody(resp)
-	if err != nil {
-		return NewInternalError("failed to read response body", err)
-	}
-	defer body.Close()
-
-	if err := json.NewDecoder(body).Decode(result); err != nil {
-		return NewInternalError("failed to decode response", err)
-	}
+	return resp, nil
+}
 
-	return nil
+// isTokenRejected reports whether a status code may indicate a stale token
+func isTokenRejected(statusCode int) bool {
+	return statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden
 }
 
 // SetTLSVerification sets TLS verification on/off
@@ -5,8 +5,16 @@ import (
     "encoding/json"
     "fmt"
     "io"
+    "iter"
     "net/http"
+    "net/url"
+    "sort"
+    "strconv"
     "strings"
+    "sync"
+    "time"
+
+    "golang.org/x/sync/errgroup"
 
     "github.com/voidarchive/nepseauth/auth"
 )
@@ -16,7 +24,7 @@ import (
 // GetMarketSummary retrieves the overall market summary
 func (h *HTTPClient) GetMarketSummary(ctx context.Context) (*MarketSummary, error) {
 	var rawItems []MarketSummaryItem
-	err := h.apiRequest(ctx, h.config.APIEndpoints["market_summary"], &rawItems)
+	err := h.apiRequest(ctx, h.endpoint(EndpointMarketSummary), &rawItems)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get market summary: %w", err)
 	}
@@ -26,7 +34,7 @@ func (h *HTTPClient) GetMarketSummary(ctx context.Context) (*MarketSummary, erro
 	for _, item := range rawItems {
 		switch item.Detail {
 		case "Total Turnover Rs:":
-			summary.TotalTurnover = item.Value
+			summary.TotalTurnover, summary.turnoverMoney = item.Value, item.exact
 		case "Total Traded Shares":
 			summary.TotalTradedShares = item.Value
 		case "Total Transactions":
@@ -34,7 +42,7 @@ func (h *HTTPClient) GetMarketSummary(ctx context.Context) (*MarketSummary, erro
 		case "Total Scrips Traded":
 			summary.TotalScripsTraded = item.Value
 		case "Total Market Capitalization Rs:":
-			summary.TotalMarketCapitalization = item.Value
+			summary.TotalMarketCapitalization, summary.marketCapMoney = item.Value, item.exact
 		case "Total Float Market Capitalization Rs:":
 			summary.TotalFloatMarketCap = item.Value
 		}
@@ -46,7 +54,7 @@ func (h *HTTPClient) GetMarketSummary(ctx context.Context) (*MarketSummary, erro
 // GetMarketStatus retrieves the current market status
 func (h *HTTPClient) GetMarketStatus(ctx context.Context) (*MarketStatus, error) {
 	var status MarketStatus
-	err := h.apiRequest(ctx, h.config.APIEndpoints["market_open"], &status)
+	err := h.apiRequest(ctx, h.endpoint(EndpointMarketOpen), &status)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get market status: %w", err)
 	}
@@ -56,36 +64,102 @@ func (h *HTTPClient) GetMarketStatus(ctx context.Context) (*MarketStatus, error)
 // GetNepseIndex retrieves the NEPSE index information
 func (h *HTTPClient) GetNepseIndex(ctx context.Context) (*NepseIndex, error) {
 	var rawIndices []NepseIndexRaw
-	err := h.apiRequest(ctx, h.config.APIEndpoints["nepse_index"], &rawIndices)
+	err := h.apiRequest(ctx, h.endpoint(EndpointNepseIndex), &rawIndices)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get NEPSE index: %w", err)
 	}
 
 	// Find the main NEPSE index (ID 58)
 	for _, rawIndex := range rawIndices {
-		if rawIndex.ID == 58 && rawIndex.Index == "NEPSE Index" {
-			return &NepseIndex{
-				IndexValue:       rawIndex.Close,
-				PercentChange:    rawIndex.PerChange,
-				PointChange:      rawIndex.Change,
-				High:             rawIndex.High,
-				Low:              rawIndex.Low,
-				PreviousClose:    rawIndex.PreviousClose,
-				FiftyTwoWeekHigh: rawIndex.FiftyTwoWeekHigh,
-				FiftyTwoWeekLow:  rawIndex.FiftyTwoWeekLow,
-				CurrentValue:     rawIndex.CurrentValue,
-				GeneratedTime:    rawIndex.GeneratedTime,
-			}, nil
+		if rawIndex.ID == IndexNepse && rawIndex.Index == "NEPSE Index" {
+			return newNepseIndex(rawIndex), nil
 		}
 	}
 
 	return nil, NewNotFoundError("NEPSE Index")
 }
 
+// GetSensitiveIndex retrieves the NEPSE Sensitive index (ID 57)
+func (h *HTTPClient) GetSensitiveIndex(ctx context.Context) (*NepseIndex, error) {
+	return h.getMainIndex(ctx, IndexSensitive, "Sensitive Index")
+}
+
+// GetFloatIndex retrieves the NEPSE Float index (ID 62)
+func (h *HTTPClient) GetFloatIndex(ctx context.Context) (*NepseIndex, error) {
+	return h.getMainIndex(ctx, IndexFloat, "Float Index")
+}
+
+// GetSensitiveFloatIndex retrieves the NEPSE Sensitive Float index (ID 63)
+func (h *HTTPClient) GetSensitiveFloatIndex(ctx context.Context) (*NepseIndex, error) {
+	return h.getMainIndex(ctx, IndexSensitiveFloat, "Sensitive Float Index")
+}
+
+// getMainIndex extracts one index by ID from the index response
+func (h *HTTPClient) getMainIndex(ctx context.Context, indexID int32, name string) (*NepseIndex, error) {
+	var rawIndices []NepseIndexRaw
+	err := h.apiRequest(ctx, h.endpoint(EndpointNepseIndex), &rawIndices)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", name, err)
+	}
+
+	for _, rawIndex := range rawIndices {
+		if rawIndex.ID == indexID {
+			return newNepseIndex(rawIndex), nil
+		}
+	}
+
+	return nil, NewNotFoundError(name)
+}
+
+// GetAllIndices retrieves the NEPSE, Sensitive, Float and Sensitive Float indices
+// from a single index fetch
+func (h *HTTPClient) GetAllIndices(ctx context.Context) (*MainIndices, error) {
+	var rawIndices []NepseIndexRaw
+	err := h.apiRequest(ctx, h.endpoint(EndpointNepseIndex), &rawIndices)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get indices: %w", err)
+	}
+
+	indices := &MainIndices{}
+	for _, rawIndex := range rawIndices {
+		switch rawIndex.ID {
+		case IndexNepse:
+			indices.Nepse = newNepseIndex(rawIndex)
+		case IndexSensitive:
+			indices.Sensitive = newNepseIndex(rawIndex)
+		case IndexFloat:
+			indices.Float = newNepseIndex(rawIndex)
+		case IndexSensitiveFloat:
+			indices.SensitiveFloat = newNepseIndex(rawIndex)
+		}
+	}
+
+	if indices.Nepse == nil {
+		return nil, NewNotFoundError("NEPSE Index")
+	}
+	return indices, nil
+}
+
+// newNepseIndex converts a raw index item into the NepseIndex shape
+func newNepseIndex(rawIndex NepseIndexRaw) *NepseIndex {
+	return &NepseIndex{
+		IndexValue:       rawIndex.Close,
+		PercentChange:    rawIndex.PerChange,
+		PointChange:      rawIndex.Change,
+		High:             rawIndex.High,
+		Low:              rawIndex.Low,
+		PreviousClose:    rawIndex.PreviousClose,
+		FiftyTwoWeekHigh: rawIndex.FiftyTwoWeekHigh,
+		FiftyTwoWeekLow:  rawIndex.FiftyTwoWeekLow,
+		CurrentValue:     rawIndex.CurrentValue,
+		GeneratedTime:    rawIndex.GeneratedTime,
+	}
+}
+
 // GetNepseSubIndices retrieves all NEPSE sub-indices
 func (h *HTTPClient) GetNepseSubIndices(ctx context.Context) ([]SubIndex, error) {
 	var rawIndices []NepseIndexRaw
-	err := h.apiRequest(ctx, h.config.APIEndpoints["nepse_index"], &rawIndices)
+	err := h.apiRequest(ctx, h.endpoint(EndpointNepseIndex), &rawIndices)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get NEPSE sub-indices: %w", err)
 	}
@@ -94,7 +168,7 @@ func (h *HTTPClient) GetNepseSubIndices(ctx context.Context) ([]SubIndex, error)
     var subIndices []SubIndex
     for _, rawIndex := range rawIndices {
         // Skip main indices (58=NEPSE, 57=Sensitive, 62=Float, 63=Sensitive Float)
-        if rawIndex.ID != 58 && rawIndex.ID != 57 && rawIndex.ID != 62 && rawIndex.ID != 63 {
+        if rawIndex.ID != IndexNepse && rawIndex.ID != IndexSensitive && rawIndex.ID != IndexFloat && rawIndex.ID != IndexSensitiveFloat {
             subIndices = append(subIndices, SubIndex(rawIndex))
         }
     }
@@ -112,21 +186,112 @@ func (h *HTTPClient) GetNepseSubIndices(ctx context.Context) ([]SubIndex, error)
     return subIndices, nil
 }
 
-// GetLiveMarket retrieves live market data
-func (h *HTTPClient) GetLiveMarket(ctx context.Context) ([]LiveMarketEntry, error) {
+// GetIndexHistory retrieves daily values of an index (e.g. IndexNepse) between two dates (YYYY-MM-DD)
+func (h *HTTPClient) GetIndexHistory(ctx context.Context, indexID int32, startDate, endDate string) ([]IndexHistoryEntry, error) {
+	if err := validateID("index ID", indexID); err != nil {
+		return nil, err
+	}
+	startDate, endDate, err := h.requestDateRange(startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := withQuery(fmt.Sprintf("%s%d", h.endpoint(EndpointIndexHistory), indexID), url.Values{
+		"size":      {"500"},
+		"startDate": {startDate},
+		"endDate":   {endDate},
+	})
+
+	history, err := fetchAllPages[IndexHistoryEntry](ctx, h, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get history of index %d: %w", indexID, err)
+	}
+	return history, nil
+}
+
+// GetMarketSummaryHistory retrieves daily market-wide totals (turnover, traded shares,
+// transactions and market capitalization) between two dates (YYYY-MM-DD)
+func (h *HTTPClient) GetMarketSummaryHistory(ctx context.Context, startDate, endDate string) ([]MarketSummaryHistoryEntry, error) {
+	startDate, endDate, err := h.requestDateRange(startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := withQuery(h.endpoint(EndpointMarketSummaryHistory), url.Values{
+		"size":      {"500"},
+		"startDate": {startDate},
+		"endDate":   {endDate},
+	})
+
+	history, err := fetchAllPages[MarketSummaryHistoryEntry](ctx, h, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get market summary history: %w", err)
+	}
+	return history, nil
+}
+
+// GetIndexConstituents retrieves the securities composing an index (e.g. IndexNepse
+// or IndexBanking) together with their weights
+func (h *HTTPClient) GetIndexConstituents(ctx context.Context, indexID int32) ([]IndexConstituent, error) {
+	if err := validateID("index ID", indexID); err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("%s%d", h.endpoint(EndpointIndexConstituents), indexID)
+
+	var constituents []IndexConstituent
+	err := h.apiRequest(ctx, endpoint, &constituents)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get constituents of index %d: %w", indexID, err)
+	}
+	return constituents, nil
+}
+
+// GetLiveMarket retrieves live market data, optionally sorted
+func (h *HTTPClient) GetLiveMarket(ctx context.Context, opts ...SortOption) ([]LiveMarketEntry, error) {
 	var liveMarket []LiveMarketEntry
-	err := h.apiRequest(ctx, h.config.APIEndpoints["live_market"], &liveMarket)
+	err := h.apiRequest(ctx, h.endpoint(EndpointLiveMarket), &liveMarket)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get live market data: %w", err)
 	}
+	SortQuotes(liveMarket, opts...)
 	return liveMarket, nil
 }
 
+// GetLiveMarketFor retrieves live market entries for the given symbols only, in one
+// request filtered client-side. Symbols not trading live are simply absent.
+func (h *HTTPClient) GetLiveMarketFor(ctx context.Context, symbols []string) ([]LiveMarketEntry, error) {
+	wanted := make(map[string]bool, len(symbols))
+	for _, symbol := range symbols {
+		symbol, err := normalizeSymbolArg(symbol)
+		if err != nil {
+			return nil, err
+		}
+		wanted[symbol] = true
+	}
+	if len(wanted) == 0 {
+		return []LiveMarketEntry{}, nil
+	}
+
+	liveMarket, err := h.GetLiveMarket(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]LiveMarketEntry, 0, len(wanted))
+	for _, entry := range liveMarket {
+		if wanted[entry.Symbol] {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
 // GetSupplyDemand retrieves supply and demand data
 func (h *HTTPClient) GetSupplyDemand(ctx context.Context) ([]SupplyDemandEntry, error) {
     // The API may return either a plain array or a paginated object with content.
     // Try array first, then fall back to paginated forms.
-    endpoint := h.config.APIEndpoints["supply_demand"]
+    endpoint := h.endpoint(EndpointSupplyDemand)
 
     // Attempt simple array decode
     var arr []SupplyDemandEntry
@@ -158,14 +323,14 @@ func (h *HTTPClient) GetSupplyDemand(ctx context.Context) ([]SupplyDemandEntry,
         }
         defer resp.Body.Close()
 
-        if resp.StatusCode == http.StatusUnauthorized && retryCount == 0 {
+        if isTokenRejected(resp.StatusCode) && retryCount == 0 {
             if err := h.authManager.ForceUpdate(ctx); err != nil {
                 return nil, fmt.Errorf("failed to refresh token: %w", err)
             }
             return decodeWithRetry(retryCount + 1)
         }
         if resp.StatusCode != http.StatusOK {
-            return nil, MapHTTPStatusToError(resp.StatusCode, resp.Status)
+            return nil, errorFromResponse(resp)
         }
 
         body, err := h.getResponseBody(resp)
@@ -226,87 +391,298 @@ func (h *HTTPClient) GetSupplyDemand(ctx context.Context) ([]SupplyDemandEntry,
 // Top Lists Methods
 
 // GetTopGainers retrieves the top gainers list
-func (h *HTTPClient) GetTopGainers(ctx context.Context) ([]TopListEntry, error) {
-	var topGainers []TopListEntry
-	err := h.apiRequest(ctx, h.config.APIEndpoints["top_gainers"], &topGainers)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get top gainers: %w", err)
-	}
-	return topGainers, nil
+func (h *HTTPClient) GetTopGainers(ctx context.Context, opts ...SortOption) ([]TopListEntry, error) {
+	return h.GetTopList(ctx, TopGainers, 0, opts...)
 }
 
 // GetTopLosers retrieves the top losers list
-func (h *HTTPClient) GetTopLosers(ctx context.Context) ([]TopListEntry, error) {
-	var topLosers []TopListEntry
-	err := h.apiRequest(ctx, h.config.APIEndpoints["top_losers"], &topLosers)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get top losers: %w", err)
-	}
-	return topLosers, nil
+func (h *HTTPClient) GetTopLosers(ctx context.Context, opts ...SortOption) ([]TopListEntry, error) {
+	return h.GetTopList(ctx, TopLosers, 0, opts...)
 }
 
 // GetTopTenTrade retrieves the top ten trade list
-func (h *HTTPClient) GetTopTenTrade(ctx context.Context) ([]TopListEntry, error) {
-	var topTrade []TopListEntry
-	err := h.apiRequest(ctx, h.config.APIEndpoints["top_ten_trade"], &topTrade)
+func (h *HTTPClient) GetTopTenTrade(ctx context.Context, opts ...SortOption) ([]TopListEntry, error) {
+	return h.GetTopList(ctx, TopTrade, 0, opts...)
+}
+
+// GetTopTenTransaction retrieves the top ten transaction list
+func (h *HTTPClient) GetTopTenTransaction(ctx context.Context, opts ...SortOption) ([]TopListEntry, error) {
+	return h.GetTopList(ctx, TopTransaction, 0, opts...)
+}
+
+// GetTopTenTurnover retrieves the top ten turnover list
+func (h *HTTPClient) GetTopTenTurnover(ctx context.Context, opts ...SortOption) ([]TopListEntry, error) {
+	return h.GetTopList(ctx, TopTurnover, 0, opts...)
+}
+
+// topListEndpoints maps each top list to its endpoint
+var topListEndpoints = map[TopList]Endpoint{
+	TopGainers:     EndpointTopGainers,
+	TopLosers:      EndpointTopLosers,
+	TopTrade:       EndpointTopTenTrade,
+	TopTransaction: EndpointTopTenTransaction,
+	TopTurnover:    EndpointTopTenTurnover,
+}
+
+// GetTopList retrieves a top list with up to limit entries, passing the size through
+// to NEPSE. A limit of zero or less returns whatever NEPSE defaults to. Sort options
+// reorder the entries NEPSE selected; they do not change which entries are listed.
+func (h *HTTPClient) GetTopList(ctx context.Context, list TopList, limit int, opts ...SortOption) ([]TopListEntry, error) {
+	endpoint, ok := topListEndpoints[list]
+	if !ok {
+		return nil, NewInvalidClientRequestError(fmt.Sprintf("unknown top list %q", list))
+	}
+
+	path := h.endpoint(endpoint)
+	if limit > 0 {
+		path = withQuery(path, url.Values{"size": {strconv.Itoa(limit)}})
+	}
+
+	var entries []TopListEntry
+	err := h.apiRequest(ctx, path, &entries)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get top ten trade: %w", err)
+		return nil, fmt.Errorf("failed to get top %s: %w", list, err)
 	}
-	return topTrade, nil
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+	SortQuotes(entries, opts...)
+	return entries, nil
 }
 
-// GetTopTenTransaction retrieves the top ten transaction list
-func (h *HTTPClient) GetTopTenTransaction(ctx context.Context) ([]TopListEntry, error) {
-	var topTransaction []TopListEntry
-	err := h.apiRequest(ctx, h.config.APIEndpoints["top_ten_transaction"], &topTransaction)
+// GetTopMarketCap retrieves the securities with the largest market capitalization.
+// A limit of zero or less returns the full ranking.
+func (h *HTTPClient) GetTopMarketCap(ctx context.Context, limit int) ([]MarketCapEntry, error) {
+	endpoint := h.endpoint(EndpointTopMarketCap)
+	if limit > 0 {
+		endpoint = withQuery(endpoint, url.Values{"size": {strconv.Itoa(limit)}})
+	}
+
+	var entries []MarketCapEntry
+	err := h.apiRequest(ctx, endpoint, &entries)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get top ten transaction: %w", err)
+		return nil, fmt.Errorf("failed to get top market cap: %w", err)
+	}
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
 	}
-	return topTransaction, nil
+	return entries, nil
 }
 
-// GetTopTenTurnover retrieves the top ten turnover list
-func (h *HTTPClient) GetTopTenTurnover(ctx context.Context) ([]TopListEntry, error) {
-	var topTurnover []TopListEntry
-	err := h.apiRequest(ctx, h.config.APIEndpoints["top_ten_turnover"], &topTurnover)
+// GetFiftyTwoWeekHighLow retrieves securities trading at or near their 52-week high and low
+func (h *HTTPClient) GetFiftyTwoWeekHighLow(ctx context.Context) (*FiftyTwoWeekHighLow, error) {
+	var highLow FiftyTwoWeekHighLow
+	err := h.apiRequest(ctx, h.endpoint(EndpointFiftyTwoWeekHighLow), &highLow)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get top ten turnover: %w", err)
+		return nil, fmt.Errorf("failed to get 52-week high/low: %w", err)
 	}
-	return topTurnover, nil
+	return &highLow, nil
 }
 
 // Price and Trading Data Methods
 
-// GetTodaysPrices retrieves today's price data, optionally filtered by business date
-func (h *HTTPClient) GetTodaysPrices(ctx context.Context, businessDate string) ([]TodayPrice, error) {
-	endpoint := h.config.APIEndpoints["todays_price"]
+// GetTodaysPrices retrieves the complete price table of a business date
+// (empty for the latest session), following every page of the response, and
+// sorts it by the given options
+func (h *HTTPClient) GetTodaysPrices(ctx context.Context, businessDate string, opts ...SortOption) ([]TodayPrice, error) {
+	businessDate, err := h.requestDate(businessDate)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{"size": {"500"}}
 	if businessDate != "" {
-		endpoint += "?businessDate=" + businessDate + "&size=500"
+		params.Set("businessDate", businessDate)
 	}
 
-	var todayPrices []TodayPrice
-	err := h.apiRequest(ctx, endpoint, &todayPrices)
+	todayPrices, err := fetchAllPages[TodayPrice](ctx, h, withQuery(h.endpoint(EndpointTodaysPrice), params))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get today's prices: %w", err)
 	}
+	SortQuotes(todayPrices, opts...)
 	return todayPrices, nil
 }
 
-// GetPriceVolumeHistory retrieves price volume history for a security by ID
+// GetTodaysPricesPage retrieves a single page (zero-based) of the price table of a
+// business date (empty for the latest session)
+func (h *HTTPClient) GetTodaysPricesPage(ctx context.Context, businessDate string, page, size int) (*Page[TodayPrice], error) {
+	if err := validatePage(page, size); err != nil {
+		return nil, err
+	}
+	businessDate, err := h.requestDate(businessDate)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{"size": {strconv.Itoa(size)}}
+	if businessDate != "" {
+		params.Set("businessDate", businessDate)
+	}
+
+	prices, err := fetchPage[TodayPrice](ctx, h, withQuery(h.endpoint(EndpointTodaysPrice), params), int32(page))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get today's prices: %w", err)
+	}
+	return prices, nil
+}
+
+// GetTodaysPricesByInstrument retrieves the price table of a business date (empty for
+// the latest session) keeping only rows of the given instrument types, e.g.
+// InstrumentEquity to leave out mutual funds, debentures and promoter shares
+func (h *HTTPClient) GetTodaysPricesByInstrument(ctx context.Context, businessDate string, instruments ...InstrumentType) ([]TodayPrice, error) {
+	if len(instruments) == 0 {
+		return nil, NewInvalidClientRequestError("at least one instrument type is required")
+	}
+
+	symbols, err := h.symbols(ctx)
+	if err != nil {
+		return nil, err
+	}
+	securities := symbols.securities
+	instrumentOf := make(map[int32]InstrumentType, len(securities))
+	for i := range securities {
+		instrumentOf[securities[i].ID] = ClassifyInstrument(&securities[i])
+	}
+
+	prices, err := h.GetTodaysPrices(ctx, businessDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []TodayPrice
+	for _, price := range prices {
+		for _, instrument := range instruments {
+			if strings.EqualFold(string(instrumentOf[price.SecurityID]), string(instrument)) {
+				filtered = append(filtered, price)
+				break
+			}
+		}
+	}
+	return filtered, nil
+}
+
+// DownloadTodaysPricesCSV streams NEPSE's CSV export of today's prices for the given
+// business date into w. This is far cheaper than paginating GetTodaysPrices for bulk use.
+func (h *HTTPClient) DownloadTodaysPricesCSV(ctx context.Context, businessDate string, w io.Writer) error {
+	if businessDate == "" {
+		return NewInvalidArgumentError("businessDate cannot be empty")
+	}
+	businessDate, err := h.requestDate(businessDate)
+	if err != nil {
+		return err
+	}
+
+	endpoint := withQuery(h.endpoint(EndpointTodaysPriceCSV), url.Values{"businessDate": {businessDate}})
+
+	resp, err := h.apiDo(ctx, http.MethodGet, endpoint, nil, 0)
+	if err != nil {
+		return fmt.Errorf("failed to download today's prices CSV: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return NewNetworkError(fmt.Errorf("failed to stream today's prices CSV: %w", err))
+	}
+	return nil
+}
+
+// GetPriceVolumeHistory retrieves price volume history for a security by ID between two
+// dates (YYYY-MM-DD). Ranges of any length are split into yearly windows and paginated.
 func (h *HTTPClient) GetPriceVolumeHistory(ctx context.Context, securityID int32, startDate, endDate string) ([]PriceHistory, error) {
-	endpoint := fmt.Sprintf("%s%d?size=500&startDate=%s&endDate=%s",
-		h.config.APIEndpoints["company_price_volume_history"], securityID, startDate, endDate)
+	if err := validateID("security ID", securityID); err != nil {
+		return nil, err
+	}
+	startDate, endDate, err := h.requestDateRange(startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	windows, err := historyWindows(startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Long ranges are fetched window by window, each window following every page
+	seen := make(map[string]bool)
+	var history []PriceHistory
+	for _, w := range windows {
+		endpoint := withQuery(fmt.Sprintf("%s%d", h.endpoint(EndpointCompanyPriceVolumeHistory), securityID), url.Values{
+			"size":      {"500"},
+			"startDate": {w[0]},
+			"endDate":   {w[1]},
+		})
+
+		entries, err := fetchAllPages[PriceHistory](ctx, h, endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get price volume history for security %d: %w", securityID, err)
+		}
+		for _, entry := range entries {
+			if seen[entry.BusinessDate.Date()] {
+				continue
+			}
+			seen[entry.BusinessDate.Date()] = true
+			history = append(history, entry)
+		}
+	}
 
-	// The API returns a paginated response with content array
-	var response struct {
-		Content []PriceHistory `json:"content"`
+	// Newest first, matching a single-page NEPSE response
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].BusinessDate.After(history[j].BusinessDate.Time)
+	})
+	return history, nil
+}
+
+// GetPriceVolumeHistoryPage retrieves a single page (zero-based) of a security's price
+// volume history between two dates (YYYY-MM-DD), without splitting the range
+func (h *HTTPClient) GetPriceVolumeHistoryPage(ctx context.Context, securityID int32, startDate, endDate string, page, size int) (*Page[PriceHistory], error) {
+	if err := validateID("security ID", securityID); err != nil {
+		return nil, err
+	}
+	if err := validatePage(page, size); err != nil {
+		return nil, err
 	}
+	startDate, endDate, err := h.requestDateRange(startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := withQuery(fmt.Sprintf("%s%d", h.endpoint(EndpointCompanyPriceVolumeHistory), securityID), url.Values{
+		"size":      {strconv.Itoa(size)},
+		"startDate": {startDate},
+		"endDate":   {endDate},
+	})
 
-	err := h.apiRequest(ctx, endpoint, &response)
+	history, err := fetchPage[PriceHistory](ctx, h, endpoint, int32(page))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get price volume history for security %d: %w", securityID, err)
 	}
-	return response.Content, nil
+	return history, nil
+}
+
+// historyChunkDays is the widest date window requested from history endpoints at once
+const historyChunkDays = 365
+
+// historyWindows splits [startDate, endDate] (YYYY-MM-DD) into consecutive windows of
+// at most historyChunkDays. An open-ended range is returned as a single window.
+func historyWindows(startDate, endDate string) ([][2]string, error) {
+	if startDate == "" || endDate == "" {
+		return [][2]string{{startDate, endDate}}, nil
+	}
+
+	if err := validateDateRange(startDate, endDate); err != nil {
+		return nil, err
+	}
+	start, _ := time.Parse(DateFormat, startDate)
+	end, _ := time.Parse(DateFormat, endDate)
+
+	var windows [][2]string
+	for from := start; !from.After(end); from = from.AddDate(0, 0, historyChunkDays) {
+		to := from.AddDate(0, 0, historyChunkDays-1)
+		if to.After(end) {
+			to = end
+		}
+		windows = append(windows, [2]string{from.Format(DateFormat), to.Format(DateFormat)})
+	}
+	return windows, nil
 }
 
 // GetPriceVolumeHistoryBySymbol retrieves price volume history for a security by symbol
@@ -320,7 +696,10 @@ func (h *HTTPClient) GetPriceVolumeHistoryBySymbol(ctx context.Context, symbol s
 
 // GetMarketDepth retrieves market depth information for a security by ID
 func (h *HTTPClient) GetMarketDepth(ctx context.Context, securityID int32) (*MarketDepth, error) {
-	endpoint := fmt.Sprintf("%s%d/", h.config.APIEndpoints["market_depth"], securityID)
+	if err := validateID("security ID", securityID); err != nil {
+		return nil, err
+	}
+	endpoint := fmt.Sprintf("%s%d/", h.endpoint(EndpointMarketDepth), securityID)
 
 	var marketDepth MarketDepth
 	err := h.apiRequest(ctx, endpoint, &marketDepth)
@@ -339,37 +718,255 @@ func (h *HTTPClient) GetMarketDepthBySymbol(ctx context.Context, symbol string)
 	return h.GetMarketDepth(ctx, security.ID)
 }
 
+// GetSupplyDemandOf retrieves total pending buy/sell quantities and order counts for one security by ID
+func (h *HTTPClient) GetSupplyDemandOf(ctx context.Context, securityID int32) (*SecuritySupplyDemand, error) {
+	if err := validateID("security ID", securityID); err != nil {
+		return nil, err
+	}
+
+	depth, err := h.GetMarketDepth(ctx, securityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get supply and demand for security %d: %w", securityID, err)
+	}
+
+	sd := &SecuritySupplyDemand{
+		SecurityID:   securityID,
+		Symbol:       depth.Symbol,
+		SecurityName: depth.SecurityName,
+	}
+	var buyQty, sellQty int64
+	for _, level := range depth.BuyDepth {
+		buyQty += level.Quantity
+		sd.BuyOrders += level.Orders
+	}
+	for _, level := range depth.SellDepth {
+		sellQty += level.Quantity
+		sd.SellOrders += level.Orders
+	}
+
+	// Prefer the exchange totals, which cover the whole book and not just the visible levels
+	sd.TotalBuyQuantity = depth.TotalBuyQuantity
+	if sd.TotalBuyQuantity == 0 {
+		sd.TotalBuyQuantity = buyQty
+	}
+	sd.TotalSellQuantity = depth.TotalSellQuantity
+	if sd.TotalSellQuantity == 0 {
+		sd.TotalSellQuantity = sellQty
+	}
+	if sd.TotalSellQuantity > 0 {
+		sd.DemandSupplyRatio = float64(sd.TotalBuyQuantity) / float64(sd.TotalSellQuantity)
+	}
+	return sd, nil
+}
+
+// GetSupplyDemandBySymbol retrieves total pending buy/sell quantities and order counts for one security by symbol
+func (h *HTTPClient) GetSupplyDemandBySymbol(ctx context.Context, symbol string) (*SecuritySupplyDemand, error) {
+	security, err := h.findSecurityBySymbol(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find security %s: %w", symbol, err)
+	}
+	return h.GetSupplyDemandOf(ctx, security.ID)
+}
+
+// maxDepthConcurrency bounds the number of in-flight requests in GetMarketDepthAll
+// and other per-security fan-outs
+const maxDepthConcurrency = 4
+
+// GetMarketDepthAll retrieves market depth for several securities concurrently,
+// keyed by upper-cased symbol. Requests still go through the client rate limiter.
+// It fails if any symbol is unknown or any depth request fails.
+func (h *HTTPClient) GetMarketDepthAll(ctx context.Context, symbols []string) (map[string]*MarketDepth, error) {
+	if len(symbols) == 0 {
+		return map[string]*MarketDepth{}, nil
+	}
+
+	index, err := h.symbols(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[string]int32, len(symbols))
+	for _, symbol := range symbols {
+		symbol, err := normalizeSymbolArg(symbol)
+		if err != nil {
+			return nil, err
+		}
+		security, ok := index.lookupSymbol(symbol)
+		if !ok {
+			return nil, newSymbolNotFoundError(symbol, index.securities)
+		}
+		wanted[symbol] = security.ID
+	}
+
+	var mu sync.Mutex
+	out := make(map[string]*MarketDepth, len(wanted))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxDepthConcurrency)
+	for symbol, id := range wanted {
+		g.Go(func() error {
+			depth, err := h.GetMarketDepth(gctx, id)
+			if err != nil {
+				return fmt.Errorf("failed to get market depth for %s: %w", symbol, err)
+			}
+			mu.Lock()
+			out[symbol] = depth
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Security and Company Methods
 
-// GetSecurityList retrieves the list of all securities
+// GetSecurityList retrieves the list of all securities that are not delisted
 func (h *HTTPClient) GetSecurityList(ctx context.Context) ([]Security, error) {
+	return h.GetSecurityListWithOptions(ctx, SecurityListOptions{})
+}
+
+// GetSecurityListWithOptions retrieves securities, optionally including delisted ones
+// and filtered by instrument type and sector
+func (h *HTTPClient) GetSecurityListWithOptions(ctx context.Context, opts SecurityListOptions) ([]Security, error) {
+	params := url.Values{}
+	params.Set("nonDelisted", strconv.FormatBool(!opts.IncludeDelisted))
+	endpoint := withQuery(h.endpoint(EndpointSecurityList), params)
+
 	var securities []Security
-	err := h.apiRequest(ctx, h.config.APIEndpoints["security_list"], &securities)
+	err := h.apiRequest(ctx, endpoint, &securities)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get security list: %w", err)
 	}
+
+	if opts.Instrument == "" && opts.Sector == "" {
+		return securities, nil
+	}
+
+	var filtered []Security
+	for i := range securities {
+		security := &securities[i]
+		if opts.Instrument != "" && !isInstrument(security, opts.Instrument) {
+			continue
+		}
+		if opts.Sector != "" && !strings.EqualFold(security.SectorName, opts.Sector) {
+			continue
+		}
+		filtered = append(filtered, *security)
+	}
+	return filtered, nil
+}
+
+// isInstrument reports whether a security is of the given instrument type as
+// ClassifyInstrument sees it, so promoter shares match InstrumentPromoterShare
+func isInstrument(security *Security, instrument InstrumentType) bool {
+	return strings.EqualFold(string(ClassifyInstrument(security)), string(instrument))
+}
+
+// GetSecurityListFiltered returns the non-delisted securities matching filter.
+// It reads the cached symbol index (see WithSymbolCacheTTL), so repeated queries
+// cost no requests, e.g. all tradable banking equities:
+//
+//	banks, err := client.GetSecurityListFiltered(ctx, nepse.SecurityFilter{
+//		Sector:         "Commercial Banks",
+//		InstrumentType: nepse.InstrumentEquity,
+//		ActiveOnly:     true,
+//	})
+func (h *HTTPClient) GetSecurityListFiltered(ctx context.Context, filter SecurityFilter) ([]Security, error) {
+	symbols, err := h.symbols(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := NormalizeText(filter.Search)
+	key := SearchKey(query)
+	var matches []Security
+	for i := range symbols.securities {
+		security := &symbols.securities[i]
+		if filter.Sector != "" && !strings.EqualFold(security.SectorName, filter.Sector) {
+			continue
+		}
+		if filter.InstrumentType != "" && !isInstrument(security, filter.InstrumentType) {
+			continue
+		}
+		if filter.ActiveOnly && !security.IsTradable() {
+			continue
+		}
+		if query != "" && !strings.Contains(strings.ToUpper(security.Symbol), strings.ToUpper(query)) &&
+			(key == "" || !strings.Contains(SearchKey(security.SecurityName), key)) {
+			continue
+		}
+		matches = append(matches, *security)
+	}
+
+	if query != "" {
+		rankSecurities(matches, query)
+	}
+	return matches, nil
+}
+
+// GetSuspendedSecurities retrieves securities that are suspended or halted from trading,
+// including the suspension reason where NEPSE publishes one
+func (h *HTTPClient) GetSuspendedSecurities(ctx context.Context) ([]Security, error) {
+	var securities []Security
+	err := h.apiRequest(ctx, h.endpoint(EndpointSuspendedSecurities), &securities)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get suspended securities: %w", err)
+	}
+	for i := range securities {
+		securities[i].IsSuspended = true
+	}
 	return securities, nil
 }
 
+// GetSecurityListByInstrument retrieves the securities of a single instrument type,
+// e.g. InstrumentEquity to exclude debentures and mutual funds
+func (h *HTTPClient) GetSecurityListByInstrument(ctx context.Context, instrument InstrumentType) ([]Security, error) {
+	return h.GetSecurityListWithOptions(ctx, SecurityListOptions{Instrument: instrument})
+}
+
+// GetDebentures retrieves listed debentures and bonds with their coupon and maturity details
+func (h *HTTPClient) GetDebentures(ctx context.Context) ([]Debenture, error) {
+	var debentures []Debenture
+	err := h.apiRequest(ctx, h.endpoint(EndpointDebentures), &debentures)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get debentures: %w", err)
+	}
+	return debentures, nil
+}
+
 // GetCompanyList retrieves the list of all companies
 func (h *HTTPClient) GetCompanyList(ctx context.Context) ([]Company, error) {
 	var companies []Company
-	err := h.apiRequest(ctx, h.config.APIEndpoints["company_list"], &companies)
+	err := h.apiRequest(ctx, h.endpoint(EndpointCompanyList), &companies)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get company list: %w", err)
 	}
 	return companies, nil
 }
 
-// GetCompanyDetails retrieves detailed information about a specific company/security by ID
-func (h *HTTPClient) GetCompanyDetails(ctx context.Context, securityID int32) (*CompanyDetails, error) {
-	endpoint := fmt.Sprintf("%s%d", h.config.APIEndpoints["company_details"], securityID)
+// GetCompanyDetailsRaw retrieves the complete, nested company details payload by security ID
+func (h *HTTPClient) GetCompanyDetailsRaw(ctx context.Context, securityID int32) (*CompanyDetailsRaw, error) {
+	if err := validateID("security ID", securityID); err != nil {
+		return nil, err
+	}
+	endpoint := fmt.Sprintf("%s%d", h.endpoint(EndpointCompanyDetails), securityID)
 
 	var rawDetails CompanyDetailsRaw
 	err := h.apiRequest(ctx, endpoint, &rawDetails)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get company details for security %d: %w", securityID, err)
 	}
+	return &rawDetails, nil
+}
+
+// GetCompanyDetails retrieves detailed information about a specific company/security by ID
+func (h *HTTPClient) GetCompanyDetails(ctx context.Context, securityID int32) (*CompanyDetails, error) {
+	rawDetails, err := h.GetCompanyDetailsRaw(ctx, securityID)
+	if err != nil {
+		return nil, err
+	}
 
 	// Convert raw nested response to flat structured response
 	details := &CompanyDetails{
@@ -394,6 +991,30 @@ func (h *HTTPClient) GetCompanyDetails(ctx context.Context, securityID int32) (*
 		FiftyTwoWeekLow:     rawDetails.SecurityMcsData.FiftyTwoWeekLow,
 		BusinessDate:        rawDetails.SecurityMcsData.BusinessDate,
 		LastUpdatedDateTime: rawDetails.SecurityMcsData.LastUpdatedDateTime,
+
+		// Share counts from the capital structure
+		ListedShares:       rawDetails.StockListedShares,
+		PublicShares:       rawDetails.PublicShares,
+		PromoterShares:     rawDetails.PromoterShares,
+		PublicPercentage:   rawDetails.PublicPercentage,
+		PromoterPercentage: rawDetails.PromoterPercentage,
+		PaidUpCapital:      rawDetails.PaidUpCapital,
+
+		IssuedCapital:        rawDetails.IssuedCapital,
+		MarketCapitalization: rawDetails.MarketCapitalization,
+		CapitalUpdatedDate:   rawDetails.UpdatedDate,
+
+		ISIN:           rawDetails.SecurityData.ISIN,
+		InstrumentType: rawDetails.SecurityData.InstrumentType.Description,
+		ShareGroup:     rawDetails.SecurityData.ShareGroup.Name,
+		FaceValue:      rawDetails.SecurityData.FaceValue,
+		TickSize:       rawDetails.SecurityData.TickSize,
+		ListingDate:    rawDetails.SecurityData.ListingDate,
+
+		CashDividend: rawDetails.SecurityData.CashDividend,
+		BonusShare:   rawDetails.SecurityData.BonusShare,
+
+		DailyTrade: rawDetails.SecurityDailyTradeDto,
 	}
 
 	return details, nil
@@ -408,48 +1029,215 @@ func (h *HTTPClient) GetCompanyDetailsBySymbol(ctx context.Context, symbol strin
 	return h.GetCompanyDetails(ctx, security.ID)
 }
 
-// GetSectorScrips groups securities by their sector using data already available in the security list
+// GetCompanyProfile retrieves company identity, registrar, contact and instrument metadata by security ID
+func (h *HTTPClient) GetCompanyProfile(ctx context.Context, securityID int32) (*CompanyProfile, error) {
+	raw, err := h.GetCompanyDetailsRaw(ctx, securityID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := raw.SecurityData
+	profile := &CompanyProfile{
+		SecurityID:         data.ID,
+		Symbol:             data.Symbol,
+		SecurityName:       data.SecurityName,
+		CompanyName:        data.Company.Name,
+		CompanyShortName:   data.Company.ShortName,
+		RegistrationNumber: data.Company.RegistrationNumber,
+		SectorName:         data.Company.Sector.Description,
+		RegulatoryBody:     data.Company.Sector.RegulatoryBody,
+
+		Website:       data.Company.Website,
+		Email:         data.Company.Email,
+		ContactPerson: data.Company.ContactPerson,
+		ContactNumber: data.Company.ContactNumber,
+		Address:       data.Company.Address,
+
+		Registrar:              data.ShareRegistrar.Name,
+		RegistrarAddress:       data.ShareRegistrar.Address,
+		RegistrarContactNumber: data.ShareRegistrar.ContactNumber,
+
+		ISIN:             data.ISIN,
+		InstrumentCode:   data.InstrumentType.Code,
+		InstrumentType:   data.InstrumentType.Description,
+		ShareGroup:       data.ShareGroup.Name,
+		FaceValue:        data.FaceValue,
+		TickSize:         data.TickSize,
+		CreditRating:     data.CreditRating,
+		ListingDate:      data.ListingDate,
+		TradingStartDate: data.TradingStartDate,
+		ActiveStatus:     data.ActiveStatus,
+		PermittedToTrade: data.PermittedToTrade,
+	}
+	if profile.SectorName == "" {
+		profile.SectorName = data.Sector
+	}
+	if profile.Email == "" {
+		profile.Email = data.Email
+	}
+	return profile, nil
+}
+
+// GetCompanyProfileBySymbol retrieves company identity, registrar, contact and instrument metadata by symbol
+func (h *HTTPClient) GetCompanyProfileBySymbol(ctx context.Context, symbol string) (*CompanyProfile, error) {
+	security, err := h.findSecurityBySymbol(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find security %s: %w", symbol, err)
+	}
+	return h.GetCompanyProfile(ctx, security.ID)
+}
+
+// GetSectors retrieves the sector master with sector IDs and names
+func (h *HTTPClient) GetSectors(ctx context.Context) ([]Sector, error) {
+	var sectors []Sector
+	err := h.apiRequest(ctx, h.endpoint(EndpointSectors), &sectors)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sectors: %w", err)
+	}
+	return sectors, nil
+}
+
+// GetSectorScrips groups every tradable security by sector, using the sectors
+// published in the security list. One request covers the whole market.
 func (h *HTTPClient) GetSectorScrips(ctx context.Context) (SectorScrips, error) {
-	// Get security list
+	return h.GetSectorScripsWithOptions(ctx, SectorScripsOptions{})
+}
+
+// GetSectorScripsWithOptions groups securities by sector. Securities the list
+// publishes without a sector are grouped under "Others", unless opts asks for
+// them to be looked up in the company list or their company details.
+func (h *HTTPClient) GetSectorScripsWithOptions(ctx context.Context, opts SectorScripsOptions) (SectorScrips, error) {
 	securities, err := h.GetSecurityList(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get security list: %w", err)
 	}
 
-	// Group securities by sector using existing sector data
-	sectorScrips := make(SectorScrips)
+	var unresolved []*Security
+	for i := range securities {
+		security := &securities[i]
+		if security.SectorName == "" && !IsPromoterShare(security) && (opts.IncludeSuspended || !security.IsSuspended) {
+			unresolved = append(unresolved, security)
+		}
+	}
+	if opts.ResolveFromCompanyList && len(unresolved) > 0 {
+		unresolved = h.resolveSectorsFromCompanyList(ctx, unresolved)
+	}
+	if opts.ResolveFromDetails && len(unresolved) > 0 {
+		if err := h.resolveSectorsFromDetails(ctx, unresolved); err != nil {
+			return nil, err
+		}
+	}
 
+	sectorScrips := make(SectorScrips)
 	for _, security := range securities {
-		// Skip suspended securities
-		if security.IsSuspended {
+		if security.IsSuspended && !opts.IncludeSuspended {
 			continue
 		}
 
-		var sectorName string
-
-		// Check for promoter shares (contain "P" suffix typically)
-		if strings.Contains(security.Symbol, "P") && strings.HasSuffix(security.Symbol, "P") {
+		// Promoter shares are grouped apart from their sector
+		sectorName := security.SectorName
+		if IsPromoterShare(&security) {
 			sectorName = "Promoter Share"
-		} else {
-			// Use the sector name from the security struct
-			sectorName = security.SectorName
-			if sectorName == "" {
-				sectorName = "Others"
-			}
+		} else if sectorName == "" {
+			sectorName = "Others"
 		}
-
-		// Initialize slice if it doesn't exist
-		if sectorScrips[sectorName] == nil {
-			sectorScrips[sectorName] = make([]string, 0)
-		}
-		
-		// Add security symbol to the sector
 		sectorScrips[sectorName] = append(sectorScrips[sectorName], security.Symbol)
 	}
 
 	return sectorScrips, nil
 }
 
+// resolveSectorsFromCompanyList fills the sectors of securities from the company
+// list and returns those it has none for. If the list cannot be fetched, every
+// security stays unresolved.
+func (h *HTTPClient) resolveSectorsFromCompanyList(ctx context.Context, securities []*Security) []*Security {
+	companies, err := h.GetCompanyList(ctx)
+	if err != nil {
+		h.logger.Debug("company list unavailable for sector lookup", "error", err)
+		return securities
+	}
+	sectorOf := make(map[string]string, len(companies))
+	for _, company := range companies {
+		sectorOf[company.Symbol] = company.SectorName
+	}
+	remaining := securities[:0]
+	for _, security := range securities {
+		security.SectorName = sectorOf[security.Symbol]
+		if security.SectorName == "" {
+			remaining = append(remaining, security)
+		}
+	}
+	return remaining
+}
+
+// resolveSectorsFromDetails fills the sectors of securities from their company
+// details, one request per security. Securities whose details fail stay unresolved.
+func (h *HTTPClient) resolveSectorsFromDetails(ctx context.Context, securities []*Security) error {
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxDepthConcurrency)
+	for _, security := range securities {
+		g.Go(func() error {
+			details, err := h.GetCompanyDetails(gctx, security.ID)
+			if err != nil {
+				if gctx.Err() != nil {
+					return gctx.Err()
+				}
+				h.logger.Debug("company details unavailable for sector", "symbol", security.Symbol, "error", err)
+				return nil
+			}
+			security.SectorName = details.SectorName
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// GetSectorSummary returns per-sector turnover, traded shares and transaction counts
+// for the latest session, computed from today's prices and ordered by turnover, highest first
+func (h *HTTPClient) GetSectorSummary(ctx context.Context) ([]SectorSummary, error) {
+	symbols, err := h.symbols(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sectorOf := make(map[int32]string, len(symbols.securities))
+	for _, security := range symbols.securities {
+		sectorOf[security.ID] = security.SectorName
+	}
+
+	prices, err := h.GetTodaysPrices(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	bySector := make(map[string]*SectorSummary)
+	for _, price := range prices {
+		sector := sectorOf[price.SecurityID]
+		if sector == "" {
+			sector = "Others"
+		}
+		summary, ok := bySector[sector]
+		if !ok {
+			summary = &SectorSummary{Sector: sector}
+			bySector[sector] = summary
+		}
+		summary.Turnover += price.TotalTradedValue
+		summary.TradedShares += price.TotalTradedQuantity
+		summary.Transactions += int64(price.TotalTrades)
+		if price.TotalTradedQuantity > 0 {
+			summary.TradedScrips++
+		}
+	}
+
+	out := make([]SectorSummary, 0, len(bySector))
+	for _, summary := range bySector {
+		out = append(out, *summary)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Turnover > out[j].Turnover
+	})
+	return out, nil
+}
+
 // Helper Methods
 
 // FindSecurity finds a security by ID
@@ -464,19 +1252,16 @@ func (h *HTTPClient) FindSecurityBySymbol(ctx context.Context, symbol string) (*
 
 // findSecurityByID finds a security by its ID
 func (h *HTTPClient) findSecurityByID(ctx context.Context, id int32) (*Security, error) {
-	if id <= 0 {
-		return nil, NewInvalidClientRequestError("security ID must be positive")
+	if err := validateID("security ID", id); err != nil {
+		return nil, err
 	}
 
-	securities, err := h.GetSecurityList(ctx)
+	symbols, err := h.symbols(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get security list: %w", err)
+		return nil, err
 	}
-
-	for _, security := range securities {
-		if security.ID == id {
-			return &security, nil
-		}
+	if security, ok := symbols.lookupID(id); ok {
+		return security, nil
 	}
 
 	return nil, NewNotFoundError(fmt.Sprintf("security with ID %d", id))
@@ -484,91 +1269,205 @@ func (h *HTTPClient) findSecurityByID(ctx context.Context, id int32) (*Security,
 
 // findSecurityBySymbol finds a security by its symbol
 func (h *HTTPClient) findSecurityBySymbol(ctx context.Context, symbol string) (*Security, error) {
-	symbol = strings.ToUpper(strings.TrimSpace(symbol))
-	if symbol == "" {
-		return nil, NewInvalidClientRequestError("symbol cannot be empty")
+	symbol, err := normalizeSymbolArg(symbol)
+	if err != nil {
+		return nil, err
 	}
 
-	securities, err := h.GetSecurityList(ctx)
+	symbols, err := h.symbols(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get security list: %w", err)
+		return nil, err
 	}
-
-	for _, security := range securities {
-		if security.Symbol == symbol {
-			return &security, nil
-		}
+	if security, ok := symbols.lookupSymbol(symbol); ok {
+		return security, nil
 	}
 
-	return nil, NewNotFoundError("security with symbol " + symbol)
+	return nil, newSymbolNotFoundError(symbol, symbols.securities)
 }
 
 
 // Floor Sheet Methods
 
-// GetFloorSheet retrieves the complete floor sheet data
+// GetFloorSheet retrieves the complete floor sheet of the latest session, like
+// GetFloorSheetAll with an empty business date
 func (h *HTTPClient) GetFloorSheet(ctx context.Context) ([]FloorSheetEntry, error) {
-    endpoint := fmt.Sprintf("%s?size=500&sort=contractId,desc", h.config.APIEndpoints["floor_sheet"])
+	return h.GetFloorSheetAll(ctx, "", nil)
+}
 
-    // Try simple array first
-    var floorSheetArray []FloorSheetEntry
-    if err := h.apiRequest(ctx, endpoint, &floorSheetArray); err == nil {
-        return floorSheetArray, nil
-    }
+// GetFloorSheetAll retrieves the complete market-wide floor sheet for a business date
+// (empty for the latest session), walking every page. progress, if non-nil, is called
+// after each page. Requests honour the client rate limiter and ctx cancellation.
+func (h *HTTPClient) GetFloorSheetAll(ctx context.Context, businessDate string, progress func(FloorSheetProgress)) ([]FloorSheetEntry, error) {
+	businessDate, err := h.requestDate(businessDate)
+	if err != nil {
+		return nil, err
+	}
 
-    // Fallback: treat as paginated like company floorsheet
-    var firstPage FloorSheetResponse
-    if err := h.apiRequest(ctx, endpoint, &firstPage); err != nil {
-        return nil, fmt.Errorf("failed to get floor sheet: %w", err)
-    }
-    all := firstPage.FloorSheets.Content
-    total := firstPage.FloorSheets.TotalPages
-    for p := int32(1); p < total; p++ {
-        pageEndpoint := fmt.Sprintf("%s&page=%d", endpoint, p)
-        var page FloorSheetResponse
-        if err := h.apiRequest(ctx, pageEndpoint, &page); err != nil {
-            return nil, fmt.Errorf("failed to get floor sheet page %d: %w", p, err)
-        }
-        all = append(all, page.FloorSheets.Content...)
-    }
-    return all, nil
-}
+	var all []FloorSheetEntry
+	for page := 0; ; page++ {
+		if err := ctx.Err(); err != nil {
+			return nil, NewNetworkError(err)
+		}
 
-// GetFloorSheetOf retrieves floor sheet data for a specific security on a specific business date by ID
-func (h *HTTPClient) GetFloorSheetOf(ctx context.Context, securityID int32, businessDate string) ([]FloorSheetEntry, error) {
+		sheet, err := h.floorSheetPage(ctx, businessDate, page, 500)
+		if err != nil {
+			return nil, err
+		}
+		if all == nil {
+			all = make([]FloorSheetEntry, 0, sheet.TotalElements)
+		}
+		all = append(all, sheet.Content...)
+
+		if progress != nil {
+			progress(FloorSheetProgress{
+				Page:       sheet.NextPage(),
+				TotalPages: sheet.TotalPages,
+				Rows:       len(all),
+				TotalRows:  sheet.TotalElements,
+			})
+		}
 
-	endpoint := fmt.Sprintf("%s%d?businessDate=%s&size=500&sort=contractid,desc",
-		h.config.APIEndpoints["company_floorsheet"], securityID, businessDate)
+		if !sheet.HasNext() {
+			return all, nil
+		}
+	}
+}
 
-	// Get first page
-	var firstPage FloorSheetResponse
-	err := h.apiRequest(ctx, endpoint, &firstPage)
+// FloorSheetAllIter streams the market-wide floor sheet for a business date (empty
+// for the latest session) page by page, like FloorSheetIter
+func (h *HTTPClient) FloorSheetAllIter(ctx context.Context, businessDate string) iter.Seq2[FloorSheetEntry, error] {
+	businessDate, err := h.requestDate(businessDate)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get floor sheet for security %d: %w", securityID, err)
+		return errIter[FloorSheetEntry](err)
+	}
+	return pageIter(ctx, func(page int) (*Page[FloorSheetEntry], error) {
+		return h.floorSheetPage(ctx, businessDate, page, 500)
+	})
+}
+
+// GetFloorSheetPage retrieves a single page (zero-based) of the market-wide floor
+// sheet for a business date (empty for the latest session), so large downloads
+// can be split up and resumed
+func (h *HTTPClient) GetFloorSheetPage(ctx context.Context, businessDate string, page, size int) (*Page[FloorSheetEntry], error) {
+	if err := validatePage(page, size); err != nil {
+		return nil, err
+	}
+	businessDate, err := h.requestDate(businessDate)
+	if err != nil {
+		return nil, err
+	}
+	return h.floorSheetPage(ctx, businessDate, page, size)
+}
+
+// floorSheetPage retrieves one page of the market-wide floor sheet for an AD business date
+func (h *HTTPClient) floorSheetPage(ctx context.Context, businessDate string, page, size int) (*Page[FloorSheetEntry], error) {
+	params := url.Values{
+		"page": {strconv.Itoa(page)},
+		"size": {strconv.Itoa(size)},
+		"sort": {"contractId,desc"},
+	}
+	if businessDate != "" {
+		params.Set("businessDate", businessDate)
+	}
+
+	var response FloorSheetResponse
+	if err := h.apiPostWithPayloadID(ctx, withQuery(h.endpoint(EndpointFloorSheet), params), payloadFloorSheet, &response); err != nil {
+		return nil, fmt.Errorf("failed to get floor sheet page %d: %w", page, err)
+	}
+	return newPage(PaginatedResponse[FloorSheetEntry](response.FloorSheets)), nil
+}
+
+// GetFloorSheetByBroker retrieves the floor sheet rows of a business date in which
+// the given broker number was the buyer or the seller
+func (h *HTTPClient) GetFloorSheetByBroker(ctx context.Context, brokerNumber int32, businessDate string) ([]FloorSheetEntry, error) {
+	if err := validateID("broker number", brokerNumber); err != nil {
+		return nil, err
 	}
 
-	// Check if there's any data
-	if len(firstPage.FloorSheets.Content) == 0 {
-		return []FloorSheetEntry{}, nil
+	all, err := h.GetFloorSheetAll(ctx, businessDate, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get floor sheet for broker %d: %w", brokerNumber, err)
 	}
 
-	allEntries := firstPage.FloorSheets.Content
-	totalPages := firstPage.FloorSheets.TotalPages
+	var entries []FloorSheetEntry
+	for _, entry := range all {
+		if entry.BuyerMemberID == brokerNumber || entry.SellerMemberID == brokerNumber {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
 
-	// Get remaining pages
-	for page := int32(1); page < totalPages; page++ {
-		pageEndpoint := fmt.Sprintf("%s&page=%d", endpoint, page)
+// GetFloorSheetOf retrieves floor sheet data for a specific security on a specific business date by ID
+func (h *HTTPClient) GetFloorSheetOf(ctx context.Context, securityID int32, businessDate string) ([]FloorSheetEntry, error) {
+	if err := validateID("security ID", securityID); err != nil {
+		return nil, err
+	}
+	businessDate, err := h.requestDate(businessDate)
+	if err != nil {
+		return nil, err
+	}
 
-		var pageResponse FloorSheetResponse
-		err := h.apiRequest(ctx, pageEndpoint, &pageResponse)
+	allEntries := []FloorSheetEntry{}
+	for page := 0; ; page++ {
+		sheet, err := h.companyFloorSheetPage(ctx, securityID, businessDate, page, 500)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get floor sheet page %d for security %d: %w", page, securityID, err)
+			return nil, err
 		}
+		allEntries = append(allEntries, sheet.Content...)
 
-		allEntries = append(allEntries, pageResponse.FloorSheets.Content...)
+		if !sheet.HasNext() {
+			return allEntries, nil
+		}
 	}
+}
 
-	return allEntries, nil
+// FloorSheetIter streams a security's floor sheet for a business date, fetching one
+// page at a time so only a single page is held in memory. Iteration stops at the
+// first error, which is yielded with a zero entry.
+func (h *HTTPClient) FloorSheetIter(ctx context.Context, securityID int32, businessDate string) iter.Seq2[FloorSheetEntry, error] {
+	if err := validateID("security ID", securityID); err != nil {
+		return errIter[FloorSheetEntry](err)
+	}
+	businessDate, err := h.requestDate(businessDate)
+	if err != nil {
+		return errIter[FloorSheetEntry](err)
+	}
+	return pageIter(ctx, func(page int) (*Page[FloorSheetEntry], error) {
+		return h.companyFloorSheetPage(ctx, securityID, businessDate, page, 500)
+	})
+}
+
+// GetFloorSheetPageOf retrieves a single page (zero-based) of a security's floor
+// sheet for a business date
+func (h *HTTPClient) GetFloorSheetPageOf(ctx context.Context, securityID int32, businessDate string, page, size int) (*Page[FloorSheetEntry], error) {
+	if err := validateID("security ID", securityID); err != nil {
+		return nil, err
+	}
+	if err := validatePage(page, size); err != nil {
+		return nil, err
+	}
+	businessDate, err := h.requestDate(businessDate)
+	if err != nil {
+		return nil, err
+	}
+	return h.companyFloorSheetPage(ctx, securityID, businessDate, page, size)
+}
+
+// companyFloorSheetPage retrieves one page of a security's floor sheet for an AD business date
+func (h *HTTPClient) companyFloorSheetPage(ctx context.Context, securityID int32, businessDate string, page, size int) (*Page[FloorSheetEntry], error) {
+	endpoint := withQuery(fmt.Sprintf("%s%d", h.endpoint(EndpointCompanyFloorsheet), securityID), url.Values{
+		"businessDate": {businessDate},
+		"page":         {strconv.Itoa(page)},
+		"size":         {strconv.Itoa(size)},
+		"sort":         {"contractid,desc"},
+	})
+
+	var response FloorSheetResponse
+	if err := h.apiRequest(ctx, endpoint, &response); err != nil {
+		return nil, fmt.Errorf("failed to get floor sheet page %d for security %d: %w", page, securityID, err)
+	}
+	return newPage(PaginatedResponse[FloorSheetEntry](response.FloorSheets)), nil
 }
 
 // GetFloorSheetBySymbol retrieves floor sheet data for a specific security by symbol
@@ -0,0 +1,95 @@
+package nepse
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ResponseMeta describes the HTTP exchanges behind one client call. A call may
+// issue several requests (pagination, token refresh, lookups), so counters and
+// durations accumulate and the per-response fields describe the last response.
+type ResponseMeta struct {
+	// Requests is the number of HTTP requests issued, not counting retries
+	Requests int
+	// Retries is the number of extra attempts made after failed requests
+	Retries int
+	// Duration is the total time spent in HTTP requests, including retry backoff
+	Duration time.Duration
+	// Endpoint, StatusCode and ServerDate describe the last response. ServerDate is
+	// parsed from its Date header and is zero if the server sent none.
+	Endpoint   string
+	StatusCode int
+	ServerDate time.Time
+	// FromCache is true if any part of the result was served from a cache: the
+	// client's in-memory caches, or an HTTP cache that reported a hit
+	FromCache bool
+}
+
+// metaRecorder guards a ResponseMeta shared by concurrent requests of one call
+type metaRecorder struct {
+	mu   sync.Mutex
+	meta *ResponseMeta
+}
+
+type metaKey struct{}
+
+// WithResponseMeta returns a context that records the metadata of calls made with
+// it into meta. Read meta once the call has returned:
+//
+//	var meta nepse.ResponseMeta
+//	summary, err := client.GetMarketSummary(nepse.WithResponseMeta(ctx, &meta))
+//	log.Println(meta.Duration, meta.Retries, meta.ServerDate)
+func WithResponseMeta(ctx context.Context, meta *ResponseMeta) context.Context {
+	return context.WithValue(ctx, metaKey{}, &metaRecorder{meta: meta})
+}
+
+// recordMeta applies update to the ResponseMeta attached to ctx, if any
+func recordMeta(ctx context.Context, update func(*ResponseMeta)) {
+	recorder, ok := ctx.Value(metaKey{}).(*metaRecorder)
+	if !ok || recorder.meta == nil {
+		return
+	}
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	update(recorder.meta)
+}
+
+// recordResponse records one HTTP request made after attempts tries
+func recordResponse(ctx context.Context, req *http.Request, resp *http.Response, attempts int, elapsed time.Duration) {
+	recordMeta(ctx, func(m *ResponseMeta) {
+		m.Requests++
+		if attempts > 1 {
+			m.Retries += attempts - 1
+		}
+		m.Duration += elapsed
+		m.Endpoint = req.URL.Path
+		if resp == nil {
+			return
+		}
+		m.StatusCode = resp.StatusCode
+		if date, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+			m.ServerDate = date.In(NepalLocation)
+		}
+		if isCacheHit(resp.Header) {
+			m.FromCache = true
+		}
+	})
+}
+
+// recordCacheHit marks the call as served from one of the client's caches
+func recordCacheHit(ctx context.Context) {
+	recordMeta(ctx, func(m *ResponseMeta) { m.FromCache = true })
+}
+
+// isCacheHit reports whether a response was served by an HTTP cache in front of NEPSE
+func isCacheHit(header http.Header) bool {
+	for _, name := range []string{"X-Cache", "CF-Cache-Status", "X-Cache-Status"} {
+		if strings.HasPrefix(strings.ToUpper(header.Get(name)), "HIT") {
+			return true
+		}
+	}
+	return false
+}
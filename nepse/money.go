@@ -0,0 +1,150 @@
+package nepse
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Money is an exact amount of Nepalese rupees stored as integer paisa (1/100 rupee).
+// Sums of Money never pick up the rounding artifacts float64 accumulates.
+//
+// The API models keep their float64 fields for compatibility. The *Money accessors on
+// TodayPrice, FloorSheetEntry and MarketSummary return the amounts decoded exactly from
+// NEPSE's JSON text instead.
+type Money int64
+
+// MoneyFromFloat converts a rupee amount to Money, rounding to the nearest paisa
+func MoneyFromFloat(rupees float64) Money {
+	return Money(math.Round(rupees * 100))
+}
+
+// ParseMoney parses a decimal rupee amount such as "1234.5", "-0.05" or "1,234.50"
+// exactly, without going through float64. Extra decimals are rounded half up.
+func ParseMoney(s string) (Money, error) {
+	v := strings.ReplaceAll(strings.TrimSpace(s), ",", "")
+	neg := strings.HasPrefix(v, "-")
+	if neg || strings.HasPrefix(v, "+") {
+		v = v[1:]
+	}
+
+	whole, frac, _ := strings.Cut(v, ".")
+	if (whole == "" && frac == "") || !isDigits(whole) || !isDigits(frac) {
+		return 0, fmt.Errorf("invalid money amount %q", s)
+	}
+
+	rupees, err := strconv.ParseInt("0"+whole, 10, 64)
+	if err != nil || rupees > math.MaxInt64/100-1 {
+		return 0, fmt.Errorf("money amount %q out of range", s)
+	}
+	roundUp := len(frac) > 2 && frac[2] >= '5'
+	frac = (frac + "00")[:2]
+	paisa := int64(frac[0]-'0')*10 + int64(frac[1]-'0')
+
+	m := Money(rupees*100 + paisa)
+	if roundUp {
+		m++
+	}
+	if neg {
+		m = -m
+	}
+	return m, nil
+}
+
+// isDigits reports whether s consists only of ASCII digits (true for "")
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Rupees returns the whole-rupee part
+func (m Money) Rupees() int64 { return int64(m) / 100 }
+
+// Paisa returns the paisa part (negative for negative amounts)
+func (m Money) Paisa() int64 { return int64(m) % 100 }
+
+// Float64 returns the amount in rupees as a float64
+func (m Money) Float64() float64 { return float64(m) / 100 }
+
+// Mul multiplies a unit price by a quantity, e.g. rate × shares
+func (m Money) Mul(quantity int64) Money { return m * Money(quantity) }
+
+// String formats the amount with two decimals, e.g. "1234.50"
+func (m Money) String() string {
+	sign := ""
+	v := int64(m)
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, v/100, v%100)
+}
+
+// MarshalJSON encodes the amount as a JSON number with two decimals
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON decodes a JSON number or numeric string exactly; null leaves m unchanged
+func (m *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	s := string(bytes.Trim(data, `"`))
+	if strings.ContainsAny(s, "eE") {
+		// Exponent notation: fall back to float parsing
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("invalid money amount %s: %w", data, err)
+		}
+		*m = MoneyFromFloat(f)
+		return nil
+	}
+	v, err := ParseMoney(s)
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+// exactMoney returns exact, an amount decoded from JSON, while the float64 field f
+// still holds it, and f converted otherwise, e.g. once f is set by hand
+func exactMoney(exact Money, f float64) Money {
+	if v, err := strconv.ParseFloat(exact.String(), 64); err == nil && v == f {
+		return exact
+	}
+	return MoneyFromFloat(f)
+}
+
+// ClosePriceMoney returns ClosePrice as Money
+func (p *TodayPrice) ClosePriceMoney() Money { return exactMoney(p.closeMoney, p.ClosePrice) }
+
+// LastTradedPriceMoney returns LastTradedPrice as Money
+func (p *TodayPrice) LastTradedPriceMoney() Money {
+	return exactMoney(p.lastTradedMoney, p.LastTradedPrice)
+}
+
+// TurnoverMoney returns TotalTradedValue as Money
+func (p *TodayPrice) TurnoverMoney() Money { return exactMoney(p.turnoverMoney, p.TotalTradedValue) }
+
+// RateMoney returns ContractRate as Money
+func (e *FloorSheetEntry) RateMoney() Money { return exactMoney(e.rateMoney, e.ContractRate) }
+
+// AmountMoney returns ContractAmount as Money
+func (e *FloorSheetEntry) AmountMoney() Money { return exactMoney(e.amountMoney, e.ContractAmount) }
+
+// TurnoverMoney returns TotalTurnover as Money
+func (s *MarketSummary) TurnoverMoney() Money { return exactMoney(s.turnoverMoney, s.TotalTurnover) }
+
+// MarketCapMoney returns TotalMarketCapitalization as Money
+func (s *MarketSummary) MarketCapMoney() Money {
+	return exactMoney(s.marketCapMoney, s.TotalMarketCapitalization)
+}
@@ -0,0 +1,221 @@
+package nepse
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// MoverCriterion is what a top movers leaderboard ranks securities by
+type MoverCriterion string
+
+const (
+	// MoversGainers ranks by percentage gain, highest first
+	MoversGainers MoverCriterion = "gainers"
+	// MoversLosers ranks by percentage loss, steepest first
+	MoversLosers MoverCriterion = "losers"
+	// MoversTurnover ranks by traded value, highest first
+	MoversTurnover MoverCriterion = "turnover"
+	// MoversVolume ranks by traded quantity, highest first
+	MoversVolume MoverCriterion = "volume"
+)
+
+// defaultMoversLimit is the leaderboard size when TopMoversOptions.Limit is zero
+const defaultMoversLimit = 10
+
+// TopMoversOptions configures SubscribeTopMovers
+type TopMoversOptions struct {
+	// Criterion to rank by (MoversGainers if empty)
+	Criterion MoverCriterion
+
+	// Limit is the leaderboard size (10 if zero)
+	Limit int
+
+	// Window ranks by what happened over the trailing window instead of the
+	// whole session: the price change since the start of the window, or the
+	// volume and turnover traded within it. Until the subscription has run for
+	// a full window, it covers the time since the first poll.
+	Window time.Duration
+}
+
+// Mover is one leaderboard row: the security's latest quote and the value it
+// was ranked by (percent change, turnover or volume)
+type Mover struct {
+	Rank  int     `json:"rank"`
+	Value float64 `json:"value"`
+	Quote Quote   `json:"quote"`
+}
+
+// TopMoversUpdate is a new leaderboard, or the error a poll failed with
+type TopMoversUpdate struct {
+	Movers []Mover
+	Time   time.Time
+	Err    error
+}
+
+// SubscribeTopMovers polls the live market every interval and sends the top
+// movers by the given criterion whenever the leaderboard changes. The live
+// market publishes no traded value, so turnover is estimated as volume times
+// last traded price.
+//
+//	movers, err := client.SubscribeTopMovers(ctx, 5*time.Second, nepse.TopMoversOptions{
+//		Criterion: nepse.MoversGainers,
+//		Window:    15 * time.Minute,
+//	})
+func (h *HTTPClient) SubscribeTopMovers(ctx context.Context, interval time.Duration, opts TopMoversOptions) (<-chan TopMoversUpdate, error) {
+	if err := validateInterval(interval); err != nil {
+		return nil, err
+	}
+	if opts.Criterion == "" {
+		opts.Criterion = MoversGainers
+	}
+	if opts.Limit == 0 {
+		opts.Limit = defaultMoversLimit
+	}
+	switch {
+	case !slices.Contains([]MoverCriterion{MoversGainers, MoversLosers, MoversTurnover, MoversVolume}, opts.Criterion):
+		return nil, NewInvalidArgumentError(fmt.Sprintf("unknown mover criterion %q", opts.Criterion))
+	case opts.Limit < 0:
+		return nil, NewInvalidArgumentError("movers limit must not be negative")
+	case opts.Window < 0:
+		return nil, NewInvalidArgumentError("movers window must not be negative")
+	}
+
+	updates := newOutbox[TopMoversUpdate](h, nil)
+	go func() {
+		defer updates.close()
+		board := moversBoard{opts: opts}
+		var last []Mover
+		sent := false
+		feed := Feed{Kind: FeedLiveMarket, Interval: interval}
+		fetch := func(ctx context.Context) ([]LiveMarketEntry, error) {
+			return h.GetLiveMarket(ctx)
+		}
+		streamFeed(ctx, h.streamTransport(), feed, fetch, func(ctx context.Context, entries []LiveMarketEntry, err error) error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err != nil {
+				updates.send(ctx, TopMoversUpdate{Time: time.Now(), Err: err})
+				return err
+			}
+			movers := board.update(time.Now(), entries)
+			if sent && slices.Equal(movers, last) {
+				return nil
+			}
+			if updates.send(ctx, TopMoversUpdate{Movers: movers, Time: time.Now()}) {
+				last, sent = movers, true
+			}
+			return nil
+		})
+	}()
+	return updates.ch, nil
+}
+
+// moverSample is the price and volume of a security at one poll
+type moverSample struct {
+	price  float64
+	volume int64
+}
+
+// moversBoard ranks securities, keeping the polls of the trailing window
+type moversBoard struct {
+	opts    TopMoversOptions
+	times   []time.Time
+	samples []map[string]moverSample
+}
+
+// update records a poll taken at now and returns the new leaderboard
+func (b *moversBoard) update(now time.Time, entries []LiveMarketEntry) []Mover {
+	quotes := make([]Quote, 0, len(entries))
+	sample := make(map[string]moverSample, len(entries))
+	for i := range entries {
+		q := entries[i].Quote()
+		quotes = append(quotes, q)
+		sample[q.Symbol] = moverSample{price: q.LastTradedPrice, volume: q.Volume}
+	}
+
+	if b.opts.Window > 0 {
+		b.times = append(b.times, now)
+		b.samples = append(b.samples, sample)
+		// Keep the newest poll at or before the window start as the baseline
+		drop := 0
+		for drop+1 < len(b.times) && !b.times[drop+1].After(now.Add(-b.opts.Window)) {
+			drop++
+		}
+		b.times = slices.Delete(b.times, 0, drop)
+		b.samples = slices.Delete(b.samples, 0, drop)
+	}
+
+	movers := make([]Mover, 0, len(quotes))
+	for _, q := range quotes {
+		value, ok := b.value(q)
+		if !ok {
+			continue
+		}
+		switch b.opts.Criterion {
+		case MoversGainers, MoversTurnover, MoversVolume:
+			ok = value > 0
+		case MoversLosers:
+			ok = value < 0
+		}
+		if ok {
+			movers = append(movers, Mover{Value: value, Quote: q})
+		}
+	}
+
+	slices.SortFunc(movers, func(x, y Mover) int {
+		c := cmp.Compare(y.Value, x.Value)
+		if b.opts.Criterion == MoversLosers {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+		return cmp.Compare(x.Quote.Symbol, y.Quote.Symbol)
+	})
+	movers = movers[:min(len(movers), b.opts.Limit)]
+	for i := range movers {
+		movers[i].Rank = i + 1
+	}
+	return movers
+}
+
+// value returns what q is ranked by; ok is false if it can't be computed
+func (b *moversBoard) value(q Quote) (value float64, ok bool) {
+	if b.opts.Window <= 0 {
+		switch b.opts.Criterion {
+		case MoversVolume:
+			return float64(q.Volume), true
+		case MoversTurnover:
+			return float64(q.Volume) * q.LastTradedPrice, true
+		default:
+			return q.PercentChange, true
+		}
+	}
+
+	// The earliest sample within the window that has the security
+	var base moverSample
+	found := false
+	for _, s := range b.samples {
+		if base, found = s[q.Symbol]; found {
+			break
+		}
+	}
+	if !found {
+		return 0, false
+	}
+	switch b.opts.Criterion {
+	case MoversVolume:
+		return float64(q.Volume - base.volume), true
+	case MoversTurnover:
+		return float64(q.Volume-base.volume) * q.LastTradedPrice, true
+	default:
+		if base.price == 0 {
+			return 0, false
+		}
+		return roundTo((q.LastTradedPrice-base.price)/base.price*100, 2), true
+	}
+}
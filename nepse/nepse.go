@@ -73,6 +73,22 @@ func NewClient(options *Options) (Client, error) {
 	return NewHTTPClient(options)
 }
 
+// New creates a new NEPSE API client configured with functional options,
+// applied on top of DefaultOptions().
+//
+//	client, err := nepse.New(
+//		nepse.WithTimeout(10*time.Second),
+//		nepse.WithRetries(5),
+//		nepse.WithRateLimit(5),
+//	)
+func New(opts ...Option) (Client, error) {
+	options := DefaultOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+	return NewClient(options)
+}
+
 // NewClientWithDefaults creates a new NEPSE API client with default settings.
 // This is a convenience function equivalent to NewClient(nil).
 func NewClientWithDefaults() (Client, error) {
@@ -99,24 +115,6 @@ const (
 	DefaultBaseURL = "https://www.nepalstock.com"
 )
 
-// Predefined error instances for common error checking
-var (
-	// ErrTokenExpired can be used with errors.Is() to check for token expiration
-	ErrTokenExpired = NewTokenExpiredError()
-
-	// ErrNetworkError can be used with errors.Is() to check for network errors
-	ErrNetworkError = NewNetworkError(nil)
-
-	// ErrUnauthorized can be used with errors.Is() to check for authorization errors
-	ErrUnauthorized = NewUnauthorizedError("unauthorized")
-
-	// ErrNotFound can be used with errors.Is() to check for not found errors
-	ErrNotFound = NewNotFoundError("resource")
-
-	// ErrRateLimit can be used with errors.Is() to check for rate limit errors
-	ErrRateLimit = NewRateLimitError()
-)
-
 // Common business date formats used by the NEPSE API
 const (
 	// DateFormat is the standard date format used by NEPSE API (YYYY-MM-DD)
@@ -126,7 +124,8 @@ const (
 	DateTimeFormat = "2006-01-02 15:04:05"
 )
 
-// Sector names commonly used in the NEPSE market
+// Sector names commonly used in the NEPSE market.
+// GetSectors returns the authoritative list with IDs.
 const (
 	SectorBanking          = "Banking"
 	SectorDevelopmentBank  = "Development Bank"
@@ -144,6 +143,27 @@ const (
 	SectorPromoterShare    = "Promoter Share"
 )
 
+// Index IDs used by the NEPSE API
+const (
+	IndexNepse            int32 = 58
+	IndexSensitive        int32 = 57
+	IndexFloat            int32 = 62
+	IndexSensitiveFloat   int32 = 63
+	IndexBanking          int32 = 51
+	IndexHotelTourism     int32 = 52
+	IndexOthers           int32 = 53
+	IndexHydro            int32 = 54
+	IndexDevelopmentBank  int32 = 55
+	IndexManufacturing    int32 = 56
+	IndexNonLifeInsurance int32 = 59
+	IndexFinance          int32 = 60
+	IndexTrading          int32 = 61
+	IndexMicrofinance     int32 = 64
+	IndexLifeInsurance    int32 = 65
+	IndexMutualFund       int32 = 66
+	IndexInvestment       int32 = 67
+)
+
 // BatchRequest represents a batch operation configuration
 type BatchRequest struct {
 	MaxConcurrency int             // Maximum number of concurrent requests
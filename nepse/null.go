@@ -0,0 +1,95 @@
+package nepse
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Null holds an optional value, distinguishing a value NEPSE reported (even zero)
+// from one it left out or sent as null. Fields such as percentage changes and
+// dividend figures use it, since a genuine 0% move and missing data otherwise
+// look the same.
+type Null[T any] struct {
+	Value T
+	Valid bool
+}
+
+// NullOf returns a valid Null holding v
+func NullOf[T any](v T) Null[T] {
+	return Null[T]{Value: v, Valid: true}
+}
+
+// Get returns the value and whether it is present
+func (n Null[T]) Get() (T, bool) {
+	return n.Value, n.Valid
+}
+
+// Or returns the value if present, otherwise fallback
+func (n Null[T]) Or(fallback T) T {
+	if n.Valid {
+		return n.Value
+	}
+	return fallback
+}
+
+// Ptr returns a pointer to a copy of the value, or nil if it is absent
+func (n Null[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+	v := n.Value
+	return &v
+}
+
+// MarshalJSON encodes the value, or null if it is absent
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Value)
+}
+
+// UnmarshalJSON decodes null, and the blank placeholders NEPSE shows for numbers
+// ("", "-", "N/A"), as absent. Numeric values sent as strings are parsed like Float.
+func (n *Null[T]) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*n = Null[T]{}
+		return nil
+	}
+
+	var v T
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		target := reflect.ValueOf(&v).Elem()
+		if !isNumberKind(target.Kind().String()) || len(data) == 0 || data[0] != '"' {
+			return err
+		}
+		var s string
+		if json.Unmarshal(data, &s) == nil && isBlankNumber(strings.TrimSpace(s)) {
+			*n = Null[T]{}
+			return nil
+		}
+		f, parseErr := parseJSONNumber(data)
+		if parseErr != nil {
+			return err
+		}
+		switch {
+		case target.CanFloat():
+			target.SetFloat(f)
+		case target.CanInt():
+			target.SetInt(int64(f))
+		default:
+			target.SetUint(uint64(f))
+		}
+	}
+	*n = NullOf(v)
+	return nil
+}
+
+// nullValue returns the value as any and whether it is present
+func (n Null[T]) nullValue() (any, bool) {
+	return n.Value, n.Valid
+}
@@ -0,0 +1,202 @@
+package nepse
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// NEPSE usually publishes numbers as JSON numbers, but some payloads intermittently
+// carry them as display strings ("1,234.50", "१२३", "-"). Float and Int accept both
+// forms. Response decoding also falls back to the same parsing for the float64 and
+// int fields of the API models, so a string-encoded number no longer fails a call.
+
+// Float is a float64 that decodes from a JSON number or a numeric string. Thousands
+// separators (including the lakh grouping "1,23,456.78") and Devanagari digits are
+// accepted; blank placeholders such as "", "-" and "N/A" decode as zero.
+type Float float64
+
+// UnmarshalJSON implements json.Unmarshaler
+func (f *Float) UnmarshalJSON(data []byte) error {
+	v, err := parseJSONNumber(data)
+	if err != nil {
+		return err
+	}
+	*f = Float(v)
+	return nil
+}
+
+// Int is an int64 that decodes from a JSON number or a numeric string, like Float.
+// Values with a fractional part are rejected.
+type Int int64
+
+// UnmarshalJSON implements json.Unmarshaler
+func (n *Int) UnmarshalJSON(data []byte) error {
+	v, err := parseJSONNumber(data)
+	if err != nil {
+		return err
+	}
+	if v != math.Trunc(v) || math.Abs(v) > math.MaxInt64 {
+		return fmt.Errorf("invalid integer %s", data)
+	}
+	*n = Int(v)
+	return nil
+}
+
+// ParseNumber parses a number as NEPSE displays it, e.g. "1,234.50", "-12.5",
+// "१,२३४" or "-" (zero)
+func ParseNumber(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	if isBlankNumber(s) {
+		return 0, nil
+	}
+
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= '०' && r <= '९':
+			b.WriteRune('0' + (r - '०'))
+		case r == ',' || r == ' ' || r == '\u00a0' || r == '_':
+			// Thousands separators
+		default:
+			b.WriteRune(r)
+		}
+	}
+	v, err := strconv.ParseFloat(b.String(), 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+	return v, nil
+}
+
+// isBlankNumber reports whether s is a placeholder NEPSE shows instead of a number
+func isBlankNumber(s string) bool {
+	switch strings.ToUpper(s) {
+	case "", "-", "--", "N/A", "NA", "NULL":
+		return true
+	}
+	return false
+}
+
+// parseJSONNumber parses a JSON number, numeric string or null
+func parseJSONNumber(data []byte) (float64, error) {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return 0, nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return 0, err
+		}
+		return ParseNumber(s)
+	}
+	v, err := strconv.ParseFloat(string(data), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %s", data)
+	}
+	return v, nil
+}
+
+// maxNumberFixes bounds how many string-encoded fields decodeJSON repairs in one response
+const maxNumberFixes = 32
+
+// decodeJSON unmarshals data into v. When a numeric field arrives as a string, the
+// offending field is rewritten as a number (or null for placeholders) wherever it
+// occurs and decoding is retried.
+func decodeJSON(data []byte, v any) error {
+	err := json.Unmarshal(data, v)
+	if err == nil {
+		return nil
+	}
+
+	var doc any
+	for fixes := 0; fixes < maxNumberFixes; fixes++ {
+		var typeErr *json.UnmarshalTypeError
+		if !errors.As(err, &typeErr) || typeErr.Value != "string" || typeErr.Field == "" || !isNumberKind(typeErr.Type.String()) {
+			return err
+		}
+		if doc == nil {
+			decoder := json.NewDecoder(bytes.NewReader(data))
+			decoder.UseNumber()
+			if decoder.Decode(&doc) != nil {
+				return err
+			}
+		}
+		if !fixNumberField(doc, nil, fieldPath(typeErr.Field)) {
+			return err
+		}
+
+		fixed, marshalErr := json.Marshal(doc)
+		if marshalErr != nil {
+			return err
+		}
+		if err = json.Unmarshal(fixed, v); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// fieldPath splits the field of a json.UnmarshalTypeError into object keys,
+// dropping the array indices recent Go versions include
+func fieldPath(field string) []string {
+	var path []string
+	for _, key := range strings.Split(field, ".") {
+		if key != "" && !isDigits(key) {
+			path = append(path, key)
+		}
+	}
+	return path
+}
+
+// isNumberKind reports whether a Go type name is a built-in numeric type
+func isNumberKind(name string) bool {
+	return strings.HasPrefix(name, "int") || strings.HasPrefix(name, "uint") || strings.HasPrefix(name, "float")
+}
+
+// fixNumberField converts the numeric strings found at every object key path
+// ending with field, returning whether any value was converted
+func fixNumberField(node any, path, field []string) bool {
+	fixed := false
+	switch n := node.(type) {
+	case []any:
+		for _, item := range n {
+			fixed = fixNumberField(item, path, field) || fixed
+		}
+	case map[string]any:
+		for key, value := range n {
+			keyPath := append(path[:len(path):len(path)], key)
+			if s, ok := value.(string); ok && hasPathSuffix(keyPath, field) {
+				if v, err := ParseNumber(s); err == nil {
+					if isBlankNumber(strings.TrimSpace(s)) {
+						n[key] = nil
+					} else {
+						n[key] = json.Number(strconv.FormatFloat(v, 'f', -1, 64))
+					}
+					fixed = true
+				}
+				continue
+			}
+			fixed = fixNumberField(value, keyPath, field) || fixed
+		}
+	}
+	return fixed
+}
+
+// hasPathSuffix reports whether path ends with suffix
+func hasPathSuffix(path, suffix []string) bool {
+	if len(suffix) > len(path) {
+		return false
+	}
+	for i, s := range suffix {
+		if path[len(path)-len(suffix)+i] != s {
+			return false
+		}
+	}
+	return true
+}
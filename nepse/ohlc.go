@@ -0,0 +1,145 @@
+package nepse
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// GetIndexOHLC builds daily OHLC candles of an index between two dates (YYYY-MM-DD),
+// oldest first. Candles come from the index history; days the history reports
+// without open/high/low, and today's session if history has not caught up yet,
+// are completed from the intraday graph.
+func (h *HTTPClient) GetIndexOHLC(ctx context.Context, indexID int32, startDate, endDate string) ([]Candle, error) {
+	history, err := h.GetIndexHistory(ctx, indexID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	_, hasGraph := indexGraphEndpoints[indexID]
+
+	candles := make([]Candle, 0, len(history)+1)
+	seen := make(map[string]bool, len(history))
+	for _, entry := range history {
+		date, err := time.ParseInLocation(DateFormat, entry.BusinessDate.Date(), NepalLocation)
+		if err != nil {
+			continue
+		}
+		candle := Candle{
+			Time:   date,
+			Open:   entry.OpenIndex,
+			High:   entry.HighIndex,
+			Low:    entry.LowIndex,
+			Close:  entry.CloseIndex,
+			Volume: entry.TurnoverVolume,
+		}
+		if hasGraph && (candle.Open == 0 || candle.High == 0 || candle.Low == 0) {
+			if intraday, ok := h.indexCandleFromGraph(ctx, indexID, date); ok {
+				candle.Open, candle.High, candle.Low = intraday.Open, intraday.High, intraday.Low
+				if candle.Close == 0 {
+					candle.Close = intraday.Close
+				}
+			}
+		}
+		seen[date.Format(DateFormat)] = true
+		candles = append(candles, candle)
+	}
+
+	// The history endpoint only publishes a session after it closes
+	today := time.Now().In(NepalLocation)
+	todayStr := today.Format(DateFormat)
+	// startDate and endDate are in the caller's calendar, which may be BS
+	callerToday := h.formatDate(today)
+	if hasGraph && !seen[todayStr] && (endDate == "" || endDate >= callerToday) && (startDate == "" || startDate <= callerToday) {
+		if candle, ok := h.indexCandleFromGraph(ctx, indexID, today); ok {
+			candles = append(candles, candle)
+		}
+	}
+
+	sort.Slice(candles, func(i, j int) bool {
+		return candles[i].Time.Before(candles[j].Time)
+	})
+	return candles, nil
+}
+
+// indexCandleFromGraph aggregates the intraday graph of one session into a candle.
+// Failures are treated as "no data" since the graph only completes the history.
+func (h *HTTPClient) indexCandleFromGraph(ctx context.Context, indexID int32, date time.Time) (Candle, bool) {
+	graph, err := h.GetIndexGraph(ctx, indexID, &GraphOptions{BusinessDate: h.formatDate(date)})
+	if err != nil {
+		h.logger.Debug("intraday graph unavailable for candle", "index", indexID, "date", date.Format(DateFormat), "error", err)
+		return Candle{}, false
+	}
+	candle, ok := candleFromPoints(graph.Data)
+	if !ok {
+		return Candle{}, false
+	}
+	y, m, d := date.Date()
+	candle.Time = time.Date(y, m, d, 0, 0, 0, 0, NepalLocation)
+	return candle, true
+}
+
+// candleFromPoints aggregates graph points, in time order, into a single candle
+func candleFromPoints(points []GraphDataPoint) (Candle, bool) {
+	if len(points) == 0 {
+		return Candle{}, false
+	}
+	candle := Candle{
+		Open:  points[0].Value,
+		High:  points[0].Value,
+		Low:   points[0].Value,
+		Close: points[len(points)-1].Value,
+	}
+	candle.Time = points[0].Date.Time
+	for _, p := range points[1:] {
+		candle.High = max(candle.High, p.Value)
+		candle.Low = min(candle.Low, p.Value)
+	}
+	return candle, true
+}
+
+// ToOHLC aggregates the graph into candles of the given interval, oldest first.
+// Buckets are aligned to midnight Nepal time, so 15*time.Minute yields candles at
+// 11:00, 11:15 and so on; an interval of zero or a day or more yields one candle
+// per session. Points without a time are skipped, and Volume is zero because
+// graphs carry no volume.
+func (g *GraphResponse) ToOHLC(interval time.Duration) []Candle {
+	if g == nil {
+		return nil
+	}
+	points := make([]GraphDataPoint, 0, len(g.Data))
+	for _, p := range g.Data {
+		if !p.Date.IsZero() {
+			points = append(points, p)
+		}
+	}
+	sort.SliceStable(points, func(i, j int) bool {
+		return points[i].Date.Before(points[j].Date.Time)
+	})
+
+	var candles []Candle
+	for start := 0; start < len(points); {
+		bucket := CandleBucket(points[start].Date.Time, interval)
+		end := start + 1
+		for end < len(points) && CandleBucket(points[end].Date.Time, interval).Equal(bucket) {
+			end++
+		}
+		candle, _ := candleFromPoints(points[start:end])
+		candle.Time = bucket
+		candles = append(candles, candle)
+		start = end
+	}
+	return candles
+}
+
+// CandleBucket returns the start of the candle of the given interval containing
+// t. Buckets are counted from midnight Nepal time; an interval of zero or a day
+// or more gives the day.
+func CandleBucket(t time.Time, interval time.Duration) time.Time {
+	t = t.In(NepalLocation)
+	y, m, d := t.Date()
+	midnight := time.Date(y, m, d, 0, 0, 0, 0, NepalLocation)
+	if interval <= 0 || interval >= 24*time.Hour {
+		return midnight
+	}
+	return midnight.Add(t.Sub(midnight) / interval * interval)
+}
@@ -0,0 +1,145 @@
+package nepse
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// Option configures a client created with New
+type Option func(*Options)
+
+// WithBaseURL overrides the NEPSE API base URL
+func WithBaseURL(baseURL string) Option {
+	return func(o *Options) {
+		o.BaseURL = baseURL
+	}
+}
+
+// WithEnvironment targets a well-known NEPSE deployment.
+// It clears any base URL set earlier so the environment takes effect.
+func WithEnvironment(env Environment) Option {
+	return func(o *Options) {
+		o.Environment = env
+		o.BaseURL = ""
+	}
+}
+
+// WithTLSVerification enables/disables TLS certificate verification
+func WithTLSVerification(enabled bool) Option {
+	return func(o *Options) {
+		o.TLSVerification = enabled
+	}
+}
+
+// WithTimeout sets the HTTP request timeout
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *Options) {
+		o.HTTPTimeout = timeout
+	}
+}
+
+// WithRetries sets the maximum number of retries for failed requests
+func WithRetries(maxRetries int) Option {
+	return func(o *Options) {
+		o.MaxRetries = maxRetries
+	}
+}
+
+// WithRetryDelay sets the base delay between retries
+func WithRetryDelay(delay time.Duration) Option {
+	return func(o *Options) {
+		o.RetryDelay = delay
+	}
+}
+
+// WithConfig overrides the default configuration
+func WithConfig(config *Config) Option {
+	return func(o *Options) {
+		o.Config = config
+	}
+}
+
+// WithHTTPClient supplies a custom *http.Client
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *Options) {
+		o.HTTPClient = client
+	}
+}
+
+// WithLogger sets the logger used for debug output
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *Options) {
+		o.Logger = logger
+	}
+}
+
+// WithRateLimit caps outgoing requests to the given number per second
+func WithRateLimit(requestsPerSecond float64) Option {
+	return func(o *Options) {
+		o.RateLimit = requestsPerSecond
+	}
+}
+
+// WithProxy routes requests through the given HTTP(S) proxy URL
+func WithProxy(proxyURL string) Option {
+	return func(o *Options) {
+		o.Proxy = proxyURL
+	}
+}
+
+// WithBSDates makes date parameters read as Bikram Sambat (YYYY-MM-DD) dates
+func WithBSDates() Option {
+	return func(o *Options) {
+		o.BSDates = true
+	}
+}
+
+// WithSchemaDriftHandler compares every decoded response against its raw JSON and
+// reports unmapped or missing fields to handler, e.g. to log payload changes:
+//
+//	nepse.WithSchemaDriftHandler(func(d nepse.SchemaDrift) {
+//		logger.Warn("nepse schema drift", "endpoint", d.Endpoint, "unmapped", d.Unmapped, "missing", d.Missing)
+//	})
+func WithSchemaDriftHandler(handler SchemaDriftHandler) Option {
+	return func(o *Options) {
+		o.SchemaDriftHandler = handler
+	}
+}
+
+// WithSymbolCacheTTL sets how long symbol lookups reuse the security list
+// (0 disables the cache)
+func WithSymbolCacheTTL(ttl time.Duration) Option {
+	return func(o *Options) {
+		o.SymbolCacheTTL = ttl
+	}
+}
+
+// WithBackpressure sets what subscriptions do when their receiver falls behind,
+// and how many updates their channels buffer before the policy applies:
+//
+//	// Keep polling at full rate; a slow UI just skips to the newest snapshot
+//	nepse.WithBackpressure(nepse.BackpressureCoalesce, 1)
+func WithBackpressure(policy BackpressurePolicy, buffer int) Option {
+	return func(o *Options) {
+		o.Backpressure = policy
+		o.SubscriptionBuffer = buffer
+	}
+}
+
+// WithStreamTransport replaces the polling transport subscriptions are built on,
+// e.g. with a push feed implementation
+func WithStreamTransport(transport Transport) Option {
+	return func(o *Options) {
+		o.StreamTransport = transport
+	}
+}
+
+// WithOffHoursPolling sets how often subscriptions poll outside trading hours
+// and on holidays (15 minutes by default). Zero pauses them until shortly
+// before the next open; a negative interval disables the trading hours schedule.
+func WithOffHoursPolling(interval time.Duration) Option {
+	return func(o *Options) {
+		o.OffHoursInterval = interval
+	}
+}
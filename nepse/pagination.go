@@ -0,0 +1,142 @@
+package nepse
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// Page is one page of a paginated NEPSE endpoint. Number is zero-based, so
+// callers can resume a large download from NextPage.
+type Page[T any] struct {
+	Content       []T   `json:"content"`
+	Number        int32 `json:"number"`
+	Size          int32 `json:"size"`
+	TotalPages    int32 `json:"totalPages"`
+	TotalElements int64 `json:"totalElements"`
+}
+
+// HasNext returns true if more pages follow this one
+func (p *Page[T]) HasNext() bool {
+	return p.Number+1 < p.TotalPages
+}
+
+// NextPage returns the number of the page following this one
+func (p *Page[T]) NextPage() int32 {
+	return p.Number + 1
+}
+
+// newPage converts a paginated response into a Page
+func newPage[T any](response PaginatedResponse[T]) *Page[T] {
+	totalPages := response.TotalPages
+	if response.Last {
+		totalPages = response.PageNumber + 1
+	}
+	return &Page[T]{
+		Content:       response.Content,
+		Number:        response.PageNumber,
+		Size:          response.Size,
+		TotalPages:    totalPages,
+		TotalElements: response.TotalElements,
+	}
+}
+
+// validatePage checks the page and size arguments of the page methods
+func validatePage(page, size int) error {
+	if page < 0 {
+		return NewInvalidArgumentError(fmt.Sprintf("page cannot be negative, got %d", page))
+	}
+	if size <= 0 {
+		return NewInvalidArgumentError(fmt.Sprintf("size must be positive, got %d", size))
+	}
+	return nil
+}
+
+// fetchPage retrieves one page of a paginated GET endpoint. Endpoints that answer
+// with a plain JSON array are returned as a single, final page.
+func fetchPage[T any](ctx context.Context, h *HTTPClient, endpoint string, page int32) (*Page[T], error) {
+	pageEndpoint := withQuery(endpoint, url.Values{"page": {strconv.Itoa(int(page))}})
+
+	var raw json.RawMessage
+	if err := h.apiRequest(ctx, pageEndpoint, &raw); err != nil {
+		return nil, fmt.Errorf("page %d: %w", page, err)
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(raw), []byte("[")) {
+		var content []T
+		if err := decodeJSON(raw, &content); err != nil {
+			return nil, NewInternalError("failed to decode response", err)
+		}
+		h.checkSchema(pageEndpoint, raw, &content)
+		return &Page[T]{
+			Content:       content,
+			Number:        page,
+			Size:          int32(len(content)),
+			TotalPages:    page + 1,
+			TotalElements: int64(len(content)),
+		}, nil
+	}
+
+	var response PaginatedResponse[T]
+	if err := decodeJSON(raw, &response); err != nil {
+		return nil, NewInternalError("failed to decode response", err)
+	}
+	h.checkSchema(pageEndpoint, raw, &response)
+	return newPage(response), nil
+}
+
+// fetchAllPages walks a paginated GET endpoint page by page and collects every item.
+// Endpoints that answer with a plain JSON array are returned as a single page.
+func fetchAllPages[T any](ctx context.Context, h *HTTPClient, endpoint string) ([]T, error) {
+	var all []T
+	for page := int32(0); ; page++ {
+		p, err := fetchPage[T](ctx, h, endpoint, page)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, p.Content...)
+
+		if !p.HasNext() {
+			return all, nil
+		}
+	}
+}
+
+// pageIter yields every item of a paginated endpoint, fetching one page at a time.
+// A failed fetch is yielded once as the error, ending the sequence.
+func pageIter[T any](ctx context.Context, fetch func(page int) (*Page[T], error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for page := 0; ; page++ {
+			if err := ctx.Err(); err != nil {
+				yield(zero, NewNetworkError(err))
+				return
+			}
+			p, err := fetch(page)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, item := range p.Content {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if !p.HasNext() {
+				return
+			}
+		}
+	}
+}
+
+// errIter yields a single error
+func errIter[T any](err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, err)
+	}
+}
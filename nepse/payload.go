@@ -0,0 +1,130 @@
+package nepse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// dummyData is the lookup table NEPSE's web client uses to derive POST payload IDs
+var dummyData = [...]int{
+	147, 117, 239, 143, 157, 312, 161, 612, 512, 804, 411, 527, 170, 511, 421, 667, 764, 621, 301, 106,
+	133, 793, 411, 511, 312, 423, 344, 346, 653, 758, 342, 222, 236, 811, 711, 611, 122, 447, 128, 199,
+	183, 135, 489, 703, 800, 745, 152, 863, 134, 211, 142, 564, 375, 793, 212, 153, 138, 153, 648, 611,
+	151, 649, 318, 143, 117, 756, 119, 141, 717, 113, 112, 146, 162, 660, 693, 261, 362, 354, 251, 641,
+	157, 178, 631, 192, 734, 445, 192, 883, 187, 122, 591, 731, 852, 384, 565, 596, 451, 772, 624, 691,
+}
+
+// payloadKind selects which variant of the payload ID an endpoint expects
+type payloadKind int
+
+const (
+	// payloadGeneral is used by index and sub-index graphs
+	payloadGeneral payloadKind = iota
+	// payloadScrips is used by security-specific endpoints
+	payloadScrips
+	// payloadFloorSheet is used by the market-wide floor sheet
+	payloadFloorSheet
+)
+
+// dummyIDCache keeps the market status ID, which only changes once per business day
+type dummyIDCache struct {
+	mu  sync.Mutex
+	id  int
+	day string
+
+	// fetches coalesces concurrent fetches of the day's ID
+	fetches singleflight.Group
+}
+
+// dummyID returns the market status ID for today, fetching it once per day.
+// The lock is not held across the fetch, so other requests are not stalled
+// behind a slow market status call.
+func (h *HTTPClient) dummyID(ctx context.Context) (int, error) {
+	today := time.Now().In(NepalLocation).Format(DateFormat)
+
+	h.dummy.mu.Lock()
+	id, day := h.dummy.id, h.dummy.day
+	h.dummy.mu.Unlock()
+	if day == today {
+		return id, nil
+	}
+
+	v, err, _ := h.dummy.fetches.Do(today, func() (any, error) {
+		status, err := h.GetMarketStatus(ctx)
+		if err != nil {
+			return 0, err
+		}
+		h.dummy.mu.Lock()
+		defer h.dummy.mu.Unlock()
+		h.dummy.id = int(status.ID)
+		h.dummy.day = today
+		return h.dummy.id, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return v.(int), nil
+}
+
+// payloadID computes the "id" NEPSE expects in POST bodies. It mirrors the
+// web client: a lookup into dummyData by market status ID, mixed with the
+// day of month and, for the general and floor sheet variants, the current token salts.
+func (h *HTTPClient) payloadID(ctx context.Context, kind payloadKind) (int, error) {
+	id, err := h.dummyID(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get dummy ID: %w", err)
+	}
+	if id < 0 || id >= len(dummyData) {
+		return 0, NewInvalidServerResponseError(fmt.Sprintf("market status ID %d out of range", id))
+	}
+
+	day := time.Now().In(NepalLocation).Day()
+	e := dummyData[id] + id + 2*day
+	if kind == payloadScrips {
+		return e, nil
+	}
+
+	salts, err := h.authManager.GetSalts(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get salts: %w", err)
+	}
+	i := 1
+	switch kind {
+	case payloadFloorSheet:
+		if e%10 >= 4 {
+			i = 3
+		}
+	default:
+		if e%10 < 5 {
+			i = 3
+		}
+	}
+	return e + salts[i]*day - salts[i-1], nil
+}
+
+// payloadBody returns a requestBody computing the payload ID for each attempt,
+// so a retry after a token refresh carries the ID of the new salts
+func (h *HTTPClient) payloadBody(kind payloadKind) requestBody {
+	return func(ctx context.Context) ([]byte, error) {
+		id, err := h.payloadID(ctx, kind)
+		if err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(map[string]int{"id": id})
+		if err != nil {
+			return nil, NewInternalError("failed to encode request payload", err)
+		}
+		return data, nil
+	}
+}
+
+// apiPostWithPayloadID performs an authenticated POST whose body is the computed payload ID
+func (h *HTTPClient) apiPostWithPayloadID(ctx context.Context, endpoint string, kind payloadKind, result any) error {
+	return h.apiRequestWithRetry(ctx, http.MethodPost, endpoint, h.payloadBody(kind), result)
+}
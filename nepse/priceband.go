@@ -0,0 +1,73 @@
+package nepse
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+// PriceBandPercent is NEPSE's daily circuit limit for a security, as a percentage
+// of the previous close
+const PriceBandPercent = 10.0
+
+// defaultTickSize is used when the security detail does not report one
+const defaultTickSize = 0.1
+
+// GetPriceBand retrieves the allowed price range of a security for the current session
+// by ID. The band is derived from the previous close per NEPSE's ±10% rule, rounded
+// inwards to the security's tick size.
+func (h *HTTPClient) GetPriceBand(ctx context.Context, securityID int32) (*PriceBand, error) {
+	if err := validateID("security ID", securityID); err != nil {
+		return nil, err
+	}
+
+	raw, err := h.GetCompanyDetailsRaw(ctx, securityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get price band for security %d: %w", securityID, err)
+	}
+
+	previousClose := raw.SecurityMcsData.PreviousClose
+	if previousClose <= 0 {
+		return nil, NewInvalidServerResponseError(fmt.Sprintf("security %d has no previous close", securityID))
+	}
+
+	band := NewPriceBand(previousClose, raw.SecurityData.TickSize)
+	band.SecurityID = securityID
+	band.Symbol = raw.SecurityData.Symbol
+	return band, nil
+}
+
+// GetPriceBandBySymbol retrieves the allowed price range of a security for the current session by symbol
+func (h *HTTPClient) GetPriceBandBySymbol(ctx context.Context, symbol string) (*PriceBand, error) {
+	security, err := h.findSecurityBySymbol(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find security %s: %w", symbol, err)
+	}
+	return h.GetPriceBand(ctx, security.ID)
+}
+
+// NewPriceBand computes the ±PriceBandPercent band around previousClose, rounded
+// inwards to tickSize (0.1 if tickSize is not positive)
+func NewPriceBand(previousClose, tickSize float64) *PriceBand {
+	if tickSize <= 0 {
+		tickSize = defaultTickSize
+	}
+	delta := previousClose * PriceBandPercent / 100
+	return &PriceBand{
+		PreviousClose: previousClose,
+		Percent:       PriceBandPercent,
+		TickSize:      tickSize,
+		Upper:         floorToTick(previousClose+delta, tickSize),
+		Lower:         ceilToTick(previousClose-delta, tickSize),
+	}
+}
+
+// floorToTick and ceilToTick round v down/up to a multiple of tick, tolerating
+// floating point noise on values that already are multiples
+func floorToTick(v, tick float64) float64 {
+	return math.Round(math.Floor(v/tick+1e-9)*tick*100) / 100
+}
+
+func ceilToTick(v, tick float64) float64 {
+	return math.Round(math.Ceil(v/tick-1e-9)*tick*100) / 100
+}
@@ -0,0 +1,98 @@
+package nepse
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// promoterMarkers are the name fragments NEPSE uses for promoter share listings
+var promoterMarkers = []string{"promoter share", "promotor share"}
+
+// IsPromoterShare reports whether a security is a promoter (locked-in) share listing
+// rather than an ordinary share. It relies on the security master's instrument and
+// name, not on the symbol, since ordinary symbols may also end in "P".
+func IsPromoterShare(security *Security) bool {
+	if security == nil {
+		return false
+	}
+	if security.Instrument != "" && security.Instrument != InstrumentEquity {
+		return false
+	}
+	return promoterBaseName(security.SecurityName) != ""
+}
+
+// ClassifyInstrument returns the instrument type of a security, distinguishing
+// promoter shares (InstrumentPromoterShare) from ordinary equity
+func ClassifyInstrument(security *Security) InstrumentType {
+	if IsPromoterShare(security) {
+		return InstrumentPromoterShare
+	}
+	return security.Instrument
+}
+
+// promoterBaseName returns the company name without the promoter marker,
+// or "" if name does not describe a promoter share
+func promoterBaseName(name string) string {
+	lower := strings.ToLower(NormalizeText(name))
+	for _, marker := range promoterMarkers {
+		if i := strings.LastIndex(lower, marker); i > 0 {
+			return strings.TrimSpace(strings.Trim(lower[:i], " -("))
+		}
+	}
+	return ""
+}
+
+// OrdinaryShareOf finds the ordinary share that a promoter share belongs to among securities.
+// It matches on company name first and falls back to the symbol without its promoter suffix.
+func OrdinaryShareOf(securities []Security, promoter *Security) (*Security, bool) {
+	base := promoterBaseName(promoter.SecurityName)
+	if base == "" {
+		return nil, false
+	}
+	baseKey := SearchKey(base)
+
+	for i := range securities {
+		s := &securities[i]
+		if s.ID == promoter.ID || IsPromoterShare(s) {
+			continue
+		}
+		if SearchKey(s.SecurityName) == baseKey {
+			return s, true
+		}
+	}
+
+	for _, suffix := range []string{"PO", "P"} {
+		symbol, ok := strings.CutSuffix(promoter.Symbol, suffix)
+		if !ok || symbol == "" {
+			continue
+		}
+		for i := range securities {
+			if securities[i].Symbol == symbol && !IsPromoterShare(&securities[i]) {
+				return &securities[i], true
+			}
+		}
+	}
+	return nil, false
+}
+
+// GetOrdinaryShareBySymbol resolves a promoter share symbol to its parent ordinary share
+func (h *HTTPClient) GetOrdinaryShareBySymbol(ctx context.Context, symbol string) (*Security, error) {
+	promoter, err := h.findSecurityBySymbol(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find security %s: %w", symbol, err)
+	}
+	if !IsPromoterShare(promoter) {
+		return nil, NewInvalidClientRequestError(fmt.Sprintf("%s is not a promoter share", promoter.Symbol))
+	}
+
+	symbols, err := h.symbols(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ordinary, ok := OrdinaryShareOf(symbols.securities, promoter)
+	if !ok {
+		return nil, NewNotFoundError("ordinary share for promoter share " + promoter.Symbol)
+	}
+	return ordinary, nil
+}
@@ -0,0 +1,136 @@
+package nepse
+
+// QuoteSource identifies the endpoint a Quote was built from
+type QuoteSource string
+
+const (
+	QuoteSourceTodayPrice     QuoteSource = "todayPrice"
+	QuoteSourceTopList        QuoteSource = "topList"
+	QuoteSourceLiveMarket     QuoteSource = "liveMarket"
+	QuoteSourceCompanyDetails QuoteSource = "companyDetails"
+)
+
+// Quote is the canonical price snapshot of a security. Today's prices, top lists,
+// the live market and company details each describe a quote with their own struct;
+// their Quote methods convert them so downstream code needs a single model.
+// Fields a source does not publish are left zero.
+type Quote struct {
+	SecurityID      int32       `json:"securityId,omitempty"`
+	Symbol          string      `json:"symbol"`
+	SecurityName    string      `json:"securityName"`
+	Open            float64     `json:"open"`
+	High            float64     `json:"high"`
+	Low             float64     `json:"low"`
+	LastTradedPrice float64     `json:"lastTradedPrice"`
+	PreviousClose   float64     `json:"previousClose"`
+	Change          float64     `json:"change"`
+	PercentChange   float64     `json:"percentChange"`
+	Volume          int64       `json:"volume"`
+	Turnover        float64     `json:"turnover,omitempty"`
+	Trades          int32       `json:"trades,omitempty"`
+	AsOf            Timestamp   `json:"asOf"`
+	Source          QuoteSource `json:"source"`
+}
+
+// Quote converts a row of today's prices into a Quote
+func (p *TodayPrice) Quote() Quote {
+	ltp := p.LastTradedPrice
+	if ltp == 0 {
+		ltp = p.ClosePrice
+	}
+	return Quote{
+		SecurityID:      p.SecurityID,
+		Symbol:          p.Symbol,
+		SecurityName:    p.SecurityName,
+		Open:            p.OpenPrice,
+		High:            p.HighPrice,
+		Low:             p.LowPrice,
+		LastTradedPrice: ltp,
+		PreviousClose:   p.PreviousClose,
+		Change:          p.DifferenceRs,
+		PercentChange:   p.PercentageChange.Or(0),
+		Volume:          p.TotalTradedQuantity,
+		Turnover:        p.TotalTradedValue,
+		Trades:          p.TotalTrades,
+		AsOf:            p.BusinessDate,
+		Source:          QuoteSourceTodayPrice,
+	}.withChange()
+}
+
+// Quote converts a top list entry into a Quote
+func (e *TopListEntry) Quote() Quote {
+	return Quote{
+		SecurityID:      e.SecurityID,
+		Symbol:          e.Symbol,
+		SecurityName:    e.SecurityName,
+		Open:            e.OpenPrice,
+		High:            e.HighPrice,
+		Low:             e.LowPrice,
+		LastTradedPrice: e.ClosePrice,
+		PreviousClose:   e.PreviousClose,
+		Change:          e.DifferenceRs,
+		PercentChange:   e.PercentageChange.Or(0),
+		Volume:          e.TotalTradedQuantity,
+		Turnover:        e.TotalTradedValue,
+		Trades:          e.TotalTrades,
+		Source:          QuoteSourceTopList,
+	}.withChange()
+}
+
+// Quote converts a live market entry into a Quote. The live market's close price
+// is the last traded price of the running session.
+func (e *LiveMarketEntry) Quote() Quote {
+	return Quote{
+		Symbol:          e.Symbol,
+		SecurityName:    e.SecurityName,
+		Open:            e.OpenPrice,
+		High:            e.HighPrice,
+		Low:             e.LowPrice,
+		LastTradedPrice: e.ClosePrice,
+		PreviousClose:   e.PreviousClose,
+		PercentChange:   e.PercentChange.Or(0),
+		Volume:          e.Volume,
+		Source:          QuoteSourceLiveMarket,
+	}.withChange()
+}
+
+// Quote converts company details into a Quote
+func (c *CompanyDetails) Quote() Quote {
+	ltp := c.LastTradedPrice
+	if ltp == 0 {
+		ltp = c.ClosePrice
+	}
+	asOf := c.LastUpdatedDateTime
+	if asOf.IsZero() {
+		asOf = c.BusinessDate
+	}
+	return Quote{
+		SecurityID:      c.ID,
+		Symbol:          c.Symbol,
+		SecurityName:    c.SecurityName,
+		Open:            c.OpenPrice,
+		High:            c.HighPrice,
+		Low:             c.LowPrice,
+		LastTradedPrice: ltp,
+		PreviousClose:   c.PreviousClose,
+		Volume:          c.TotalTradeQuantity,
+		Trades:          c.TotalTrades,
+		AsOf:            asOf,
+		Source:          QuoteSourceCompanyDetails,
+	}.withChange()
+}
+
+// withChange derives the point and percentage change from the previous close
+// when the source did not publish them
+func (q Quote) withChange() Quote {
+	if q.PreviousClose == 0 || q.LastTradedPrice == 0 {
+		return q
+	}
+	if q.Change == 0 {
+		q.Change = roundTo(q.LastTradedPrice-q.PreviousClose, 2)
+	}
+	if q.PercentChange == 0 {
+		q.PercentChange = roundTo(q.Change/q.PreviousClose*100, 2)
+	}
+	return q
+}
@@ -0,0 +1,84 @@
+package nepse
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultOffHoursInterval is how often subscriptions poll outside trading hours
+// unless WithOffHoursPolling says otherwise
+const DefaultOffHoursInterval = 15 * time.Minute
+
+// DefaultSessionMargin is how long before the open and after the close polling
+// runs at full rate when a TradingHoursSchedule has no Margin. It covers the
+// pre-open session.
+const DefaultSessionMargin = MarketOpens - PreOpenStart
+
+// TradingHoursSchedule slows pollers down outside NEPSE trading sessions,
+// including weekends and holidays from the trading calendar. From Margin before
+// a session's open to Margin after its close pollers run at their own interval;
+// otherwise they wait OffHoursInterval, or until the next session's margin
+// starts if that comes first. A zero OffHoursInterval pauses polling until then.
+type TradingHoursSchedule struct {
+	// Calendar returns the trading calendar of a year, e.g. Client.GetTradingCalendar
+	Calendar func(ctx context.Context, year int) (*TradingCalendar, error)
+
+	// OffHoursInterval is the wait between polls outside sessions (0 pauses)
+	OffHoursInterval time.Duration
+
+	// Margin around each session (DefaultSessionMargin if zero)
+	Margin time.Duration
+}
+
+// Wait returns how long a poller running every interval should wait after a
+// poll at now. If the calendar can't be loaded, sessions are expected on every
+// weekday, ignoring holidays.
+func (s *TradingHoursSchedule) Wait(ctx context.Context, now time.Time, interval time.Duration) time.Duration {
+	margin := s.Margin
+	if margin <= 0 {
+		margin = DefaultSessionMargin
+	}
+	now = now.In(NepalLocation)
+
+	calendar := s.calendar(ctx, now.Year())
+	if at := sinceMidnight(now); calendar.IsTradingDay(now) && at >= MarketOpens-margin && at < MarketCloses+margin {
+		return interval
+	}
+
+	open, ok := calendar.NextOpen(now)
+	if !ok {
+		if open, ok = s.calendar(ctx, now.Year()+1).NextOpen(now); !ok {
+			return interval
+		}
+	}
+	wait := open.Add(-margin).Sub(now)
+	if wait <= interval {
+		return interval
+	}
+	if s.OffHoursInterval > 0 {
+		wait = min(wait, max(interval, s.OffHoursInterval))
+	}
+	return wait
+}
+
+// calendar returns the trading calendar of a year, or one without holidays if it
+// can't be loaded
+func (s *TradingHoursSchedule) calendar(ctx context.Context, year int) *TradingCalendar {
+	calendar, err := s.Calendar(ctx, year)
+	if err != nil {
+		return NewTradingCalendar(year, nil)
+	}
+	return calendar
+}
+
+// tradingHoursSchedule returns the schedule of the client's subscriptions, or
+// nil if off-hours polling was disabled
+func (h *HTTPClient) tradingHoursSchedule() *TradingHoursSchedule {
+	if h.options.OffHoursInterval < 0 {
+		return nil
+	}
+	return &TradingHoursSchedule{
+		Calendar:         h.GetTradingCalendar,
+		OffHoursInterval: h.options.OffHoursInterval,
+	}
+}
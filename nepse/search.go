@@ -0,0 +1,57 @@
+package nepse
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// SearchSecurities looks up securities whose symbol or name matches query using
+// NEPSE's search endpoint. Matches are ranked: exact symbol, symbol prefix, name
+// prefix, then any other match, ties broken alphabetically by symbol.
+func (h *HTTPClient) SearchSecurities(ctx context.Context, query string) ([]Security, error) {
+	query = NormalizeText(query)
+	if query == "" {
+		return nil, NewInvalidClientRequestError("search query cannot be empty")
+	}
+
+	endpoint := withQuery(h.endpoint(EndpointSecuritySearch), url.Values{"keyword": {query}})
+
+	var matches []Security
+	err := h.apiRequest(ctx, endpoint, &matches)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search securities for %q: %w", query, err)
+	}
+
+	rankSecurities(matches, query)
+	return matches, nil
+}
+
+// rankSecurities orders securities by how well they match query. Names are
+// compared by SearchKey, so case, punctuation and Devanagari input do not matter.
+func rankSecurities(securities []Security, query string) {
+	q := strings.ToUpper(query)
+	key := SearchKey(query)
+	rank := func(s *Security) int {
+		symbol := strings.ToUpper(s.Symbol)
+		switch {
+		case symbol == q:
+			return 0
+		case strings.HasPrefix(symbol, q):
+			return 1
+		case key != "" && strings.HasPrefix(SearchKey(s.SecurityName), key):
+			return 2
+		default:
+			return 3
+		}
+	}
+	sort.SliceStable(securities, func(i, j int) bool {
+		ri, rj := rank(&securities[i]), rank(&securities[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return securities[i].Symbol < securities[j].Symbol
+	})
+}
@@ -0,0 +1,243 @@
+package nepse
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// NEPSE session times, as offsets from midnight Nepal time. Orders are collected
+// during the pre-open session and continuous trading runs from open to close.
+const (
+	PreOpenStart = 10*time.Hour + 30*time.Minute
+	MarketOpens  = 11 * time.Hour
+	MarketCloses = 15 * time.Hour
+)
+
+// IsPreOpen returns true if NEPSE reports the pre-open session, or if the status
+// is closed but was taken during the pre-open window of a weekday
+func (m *MarketStatus) IsPreOpen() bool {
+	if m.IsOpen == MarketStatePreOpen {
+		return true
+	}
+	if m.IsOpen == MarketStateOpen || m.AsOf.IsZero() || IsWeekend(m.AsOf.Time) {
+		return false
+	}
+	at := sinceMidnight(m.AsOf.Time)
+	return at >= PreOpenStart && at < MarketOpens
+}
+
+// IsTradingHours returns true if now falls within continuous trading hours
+// (Sunday to Thursday, 11:00-15:00 Nepal time). Holidays are not considered;
+// use TradingCalendar.IsTradingHours for that.
+func IsTradingHours(now time.Time) bool {
+	if IsWeekend(now) {
+		return false
+	}
+	at := sinceMidnight(now)
+	return at >= MarketOpens && at < MarketCloses
+}
+
+// IsTradingHours returns true if now falls within trading hours of a trading day
+func (c *TradingCalendar) IsTradingHours(now time.Time) bool {
+	return IsTradingHours(now) && c.IsTradingDay(now)
+}
+
+// NextOpen returns the start of the first session opening after the given time.
+// ok is false if no trading day is left in the calendar year.
+func (c *TradingCalendar) NextOpen(after time.Time) (time.Time, bool) {
+	return c.nextSessionTime(after, MarketOpens)
+}
+
+// NextClose returns the end of the running session, or of the next session if the
+// market is closed. ok is false if no trading day is left in the calendar year.
+func (c *TradingCalendar) NextClose(after time.Time) (time.Time, bool) {
+	return c.nextSessionTime(after, MarketCloses)
+}
+
+// nextSessionTime returns the first trading day time at offset strictly after the given time
+func (c *TradingCalendar) nextSessionTime(after time.Time, offset time.Duration) (time.Time, bool) {
+	t := after.In(NepalLocation)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, NepalLocation)
+	for ; day.Year() <= c.Year; day = day.AddDate(0, 0, 1) {
+		at := day.Add(offset)
+		if day.Year() == c.Year && at.After(t) && c.IsTradingDay(day) {
+			return at, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// NextMarketOpen returns when the next NEPSE session opens after now, using the
+// holiday calendars of this year and the next
+func (h *HTTPClient) NextMarketOpen(ctx context.Context) (time.Time, error) {
+	return h.nextSessionTime(ctx, (*TradingCalendar).NextOpen)
+}
+
+// NextMarketClose returns when the running NEPSE session closes, or the next one
+// if the market is closed now
+func (h *HTTPClient) NextMarketClose(ctx context.Context) (time.Time, error) {
+	return h.nextSessionTime(ctx, (*TradingCalendar).NextClose)
+}
+
+// nextSessionTime applies next to this year's calendar and then the next year's
+func (h *HTTPClient) nextSessionTime(ctx context.Context, next func(*TradingCalendar, time.Time) (time.Time, bool)) (time.Time, error) {
+	now := time.Now().In(NepalLocation)
+	for _, year := range []int{now.Year(), now.Year() + 1} {
+		calendar, err := h.GetTradingCalendar(ctx, year)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if t, ok := next(calendar, now); ok {
+			return t, nil
+		}
+	}
+	return time.Time{}, NewNotFoundError(fmt.Sprintf("trading session after %s", now.Format(DateFormat)))
+}
+
+// sinceMidnight returns how long after midnight, Nepal time, t falls
+func sinceMidnight(t time.Time) time.Duration {
+	t = t.In(NepalLocation)
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+}
+
+// MarketWatchOptions configures WatchMarket. Zero durations take the defaults.
+type MarketWatchOptions struct {
+	// OnOpen is called when the market opens for continuous trading
+	OnOpen func(status *MarketStatus)
+
+	// OnClose is called when the market closes
+	OnClose func(status *MarketStatus)
+
+	// OnTransition is called on every state change, including pre-open
+	OnTransition func(from, to MarketState, status *MarketStatus)
+
+	// OnError receives errors of failed polls; watching continues. A failing
+	// trading calendar is reported once until it loads again.
+	OnError func(error)
+
+	// Interval between polls near a session boundary (15 seconds by default)
+	Interval time.Duration
+
+	// Window is how long before and after an expected boundary the status is
+	// polled at Interval (10 minutes by default)
+	Window time.Duration
+
+	// IdleInterval is the longest wait between polls away from boundaries, so
+	// unscheduled halts are still noticed (10 minutes by default)
+	IdleInterval time.Duration
+}
+
+func (o *MarketWatchOptions) withDefaults() MarketWatchOptions {
+	opts := *o
+	if opts.Interval <= 0 {
+		opts.Interval = 15 * time.Second
+	}
+	if opts.Window <= 0 {
+		opts.Window = 10 * time.Minute
+	}
+	if opts.IdleInterval <= 0 {
+		opts.IdleInterval = 10 * time.Minute
+	}
+	return opts
+}
+
+// WatchMarket follows the market status until ctx is done, calling the option
+// callbacks on each transition, and returns ctx's error. It polls frequently only
+// around the expected open and close from the trading calendar, so it costs a
+// handful of requests outside those windows:
+//
+//	go client.WatchMarket(ctx, nepse.MarketWatchOptions{
+//		OnOpen:  func(*nepse.MarketStatus) { archiver.Start() },
+//		OnClose: func(*nepse.MarketStatus) { archiver.Stop() },
+//	})
+//
+// The state at start is taken as the baseline; callbacks fire only on changes.
+func (h *HTTPClient) WatchMarket(ctx context.Context, opts MarketWatchOptions) error {
+	opts = opts.withDefaults()
+	var state MarketState
+	known := false
+	calendarFailing := false
+	for {
+		status, err := h.GetMarketStatus(ctx)
+		switch {
+		case ctx.Err() != nil:
+			return ctx.Err()
+		case err != nil:
+			if opts.OnError != nil {
+				opts.OnError(err)
+			}
+		default:
+			if known && status.IsOpen != state {
+				opts.transition(state, status)
+			}
+			state, known = status.IsOpen, true
+		}
+
+		wait, err := h.nextMarketPoll(ctx, state, opts)
+		// Report a failing calendar once rather than on every poll
+		if err != nil && !calendarFailing && opts.OnError != nil && ctx.Err() == nil {
+			opts.OnError(err)
+		}
+		calendarFailing = err != nil
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// transition calls the callbacks for a change from one state to status
+func (o *MarketWatchOptions) transition(from MarketState, status *MarketStatus) {
+	if o.OnTransition != nil {
+		o.OnTransition(from, status.IsOpen, status)
+	}
+	switch {
+	case status.IsOpen == MarketStateOpen && o.OnOpen != nil:
+		o.OnOpen(status)
+	case from == MarketStateOpen && o.OnClose != nil:
+		o.OnClose(status)
+	}
+}
+
+// nextMarketPoll returns how long to wait before the next status poll: Interval
+// within Window of the expected boundary, otherwise until the window starts,
+// capped at IdleInterval. If the trading calendar fails it returns the error and
+// expects the boundary on the fixed session clock, ignoring holidays.
+func (h *HTTPClient) nextMarketPoll(ctx context.Context, state MarketState, opts MarketWatchOptions) (time.Duration, error) {
+	next, offset := h.NextMarketOpen, MarketOpens
+	if state == MarketStateOpen {
+		next, offset = h.NextMarketClose, MarketCloses
+	}
+	now := time.Now()
+	boundary, err := next(ctx)
+	if err != nil {
+		boundary = sessionClockTime(now, offset)
+	}
+
+	// A boundary that just passed without a transition (NEPSE opening or closing
+	// late) shows up as the next day's; keep polling through the window after it
+	if at := sinceMidnight(now); !IsWeekend(now) && at >= offset && at < offset+opts.Window {
+		return opts.Interval, err
+	}
+	untilWindow := boundary.Sub(now) - opts.Window
+	if untilWindow <= 0 {
+		return opts.Interval, err
+	}
+	return min(untilWindow, opts.IdleInterval), err
+}
+
+// sessionClockTime returns the first time at offset after the given time on a
+// Sunday to Thursday, ignoring holidays
+func sessionClockTime(after time.Time, offset time.Duration) time.Time {
+	year := after.In(NepalLocation).Year()
+	t, ok := NewTradingCalendar(year, nil).nextSessionTime(after, offset)
+	if !ok {
+		t, _ = NewTradingCalendar(year+1, nil).nextSessionTime(after, offset)
+	}
+	return t
+}
@@ -0,0 +1,128 @@
+package nepse
+
+import (
+	"slices"
+	"strconv"
+)
+
+// Clone and Equal let change detection and concurrent consumers work on market
+// snapshots without reflect.DeepEqual or aliasing each other's slices. Equal
+// compares timestamps by instant, ignoring the raw string NEPSE sent.
+
+// Clone returns a copy of the summary
+func (s *MarketSummary) Clone() *MarketSummary {
+	if s == nil {
+		return nil
+	}
+	c := *s
+	return &c
+}
+
+// Equal reports whether two summaries hold the same totals
+func (s *MarketSummary) Equal(o *MarketSummary) bool {
+	if s == nil || o == nil {
+		return s == o
+	}
+	return *s == *o
+}
+
+// Clone returns a deep copy of the market depth, sharing no slices with it
+func (d *MarketDepth) Clone() *MarketDepth {
+	if d == nil {
+		return nil
+	}
+	c := *d
+	c.BuyDepth = slices.Clone(d.BuyDepth)
+	c.SellDepth = slices.Clone(d.SellDepth)
+	return &c
+}
+
+// Equal reports whether two market depths have the same totals and price levels
+func (d *MarketDepth) Equal(o *MarketDepth) bool {
+	if d == nil || o == nil {
+		return d == o
+	}
+	return d.SecurityID == o.SecurityID &&
+		d.Symbol == o.Symbol &&
+		d.SecurityName == o.SecurityName &&
+		d.TotalBuyQuantity == o.TotalBuyQuantity &&
+		d.TotalSellQuantity == o.TotalSellQuantity &&
+		slices.Equal(d.BuyDepth, o.BuyDepth) &&
+		slices.Equal(d.SellDepth, o.SellDepth)
+}
+
+// Equal reports whether two price rows hold the same values
+func (p *TodayPrice) Equal(o *TodayPrice) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	a, b := *p, *o
+	if !a.BusinessDate.Equal(b.BusinessDate.Time) || !a.LastUpdatedTime.Equal(b.LastUpdatedTime.Time) {
+		return false
+	}
+	a.BusinessDate, b.BusinessDate = Timestamp{}, Timestamp{}
+	a.LastUpdatedTime, b.LastUpdatedTime = Timestamp{}, Timestamp{}
+	return a == b
+}
+
+// TodayPrices is a price table, as returned by GetTodaysPrices. Convert a result
+// with TodayPrices(prices) to clone or compare it.
+type TodayPrices []TodayPrice
+
+// Clone returns a copy of the table. TodayPrice holds no references, so the
+// copy shares no memory with the original.
+func (t TodayPrices) Clone() TodayPrices {
+	return slices.Clone(t)
+}
+
+// Equal reports whether two tables hold equal rows in the same order
+func (t TodayPrices) Equal(o TodayPrices) bool {
+	return slices.EqualFunc(t, o, func(a, b TodayPrice) bool { return a.Equal(&b) })
+}
+
+// TodayPricesDiff lists the rows that differ between two price tables
+type TodayPricesDiff struct {
+	// Changed holds rows of the new table that are new or differ from the old one
+	Changed []TodayPrice `json:"changed"`
+	// Removed holds rows of the old table absent from the new one
+	Removed []TodayPrice `json:"removed"`
+}
+
+// Empty reports whether the tables were equal
+func (d TodayPricesDiff) Empty() bool {
+	return len(d.Changed) == 0 && len(d.Removed) == 0
+}
+
+// DiffTodayPrices compares two price tables row by row, matching rows by
+// security ID (or symbol when the ID is missing). Changed rows are in the order
+// of next, removed rows in the order of prev.
+func DiffTodayPrices(prev, next []TodayPrice) TodayPricesDiff {
+	old := make(map[string]*TodayPrice, len(prev))
+	for i := range prev {
+		old[todayPriceKey(&prev[i])] = &prev[i]
+	}
+
+	var diff TodayPricesDiff
+	seen := make(map[string]bool, len(next))
+	for i := range next {
+		key := todayPriceKey(&next[i])
+		seen[key] = true
+		if p, ok := old[key]; !ok || !p.Equal(&next[i]) {
+			diff.Changed = append(diff.Changed, next[i])
+		}
+	}
+	for i := range prev {
+		if !seen[todayPriceKey(&prev[i])] {
+			diff.Removed = append(diff.Removed, prev[i])
+		}
+	}
+	return diff
+}
+
+// todayPriceKey identifies the security of a price row
+func todayPriceKey(p *TodayPrice) string {
+	if p.SecurityID != 0 {
+		return strconv.Itoa(int(p.SecurityID))
+	}
+	return p.Symbol
+}
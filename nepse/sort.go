@@ -0,0 +1,106 @@
+package nepse
+
+import (
+	"cmp"
+	"sort"
+)
+
+// SortOption orders the results of list getters such as GetTodaysPrices,
+// GetLiveMarket and GetTopList client-side. Combine a key with Descending:
+//
+//	prices, err := client.GetTodaysPrices(ctx, "", nepse.SortByTurnover(), nepse.Descending())
+//
+// Without a key, results keep NEPSE's order. Ties are broken by ascending symbol.
+type SortOption func(*sortSpec)
+
+// sortSpec is the ordering assembled from SortOptions
+type sortSpec struct {
+	key        func(q *Quote) float64
+	bySymbol   bool
+	descending bool
+}
+
+// SortBySymbol orders results alphabetically by symbol
+func SortBySymbol() SortOption {
+	return func(s *sortSpec) { s.key, s.bySymbol = nil, true }
+}
+
+// SortByPercentChange orders results by percentage change
+func SortByPercentChange() SortOption {
+	return sortByKey(func(q *Quote) float64 { return q.PercentChange })
+}
+
+// SortByTurnover orders results by traded value
+func SortByTurnover() SortOption {
+	return sortByKey(func(q *Quote) float64 { return q.Turnover })
+}
+
+// SortByVolume orders results by traded quantity
+func SortByVolume() SortOption {
+	return sortByKey(func(q *Quote) float64 { return float64(q.Volume) })
+}
+
+// SortByPrice orders results by last traded price
+func SortByPrice() SortOption {
+	return sortByKey(func(q *Quote) float64 { return q.LastTradedPrice })
+}
+
+// Descending reverses the order of the sort key (ascending by default)
+func Descending() SortOption {
+	return func(s *sortSpec) { s.descending = true }
+}
+
+// sortByKey returns an option sorting by a numeric quote field
+func sortByKey(key func(q *Quote) float64) SortOption {
+	return func(s *sortSpec) { s.key, s.bySymbol = key, false }
+}
+
+// SortQuotes sorts any slice of quote sources (TodayPrice, TopListEntry,
+// LiveMarketEntry, CompanyDetails) in place by the given options. The sort is
+// stable and each element is converted to a Quote only once.
+func SortQuotes[T any, P interface {
+	*T
+	Quote() Quote
+}](items []T, opts ...SortOption) {
+	var spec sortSpec
+	for _, opt := range opts {
+		opt(&spec)
+	}
+	if spec.key == nil && !spec.bySymbol {
+		return
+	}
+
+	quotes := make([]Quote, len(items))
+	for i := range items {
+		quotes[i] = P(&items[i]).Quote()
+	}
+	sort.Stable(quoteSorter[T]{items: items, quotes: quotes, spec: spec})
+}
+
+// quoteSorter sorts items and their precomputed quotes together
+type quoteSorter[T any] struct {
+	items  []T
+	quotes []Quote
+	spec   sortSpec
+}
+
+func (s quoteSorter[T]) Len() int { return len(s.items) }
+
+func (s quoteSorter[T]) Swap(i, j int) {
+	s.items[i], s.items[j] = s.items[j], s.items[i]
+	s.quotes[i], s.quotes[j] = s.quotes[j], s.quotes[i]
+}
+
+func (s quoteSorter[T]) Less(i, j int) bool {
+	a, b := &s.quotes[i], &s.quotes[j]
+	if s.spec.key != nil {
+		if c := cmp.Compare(s.spec.key(a), s.spec.key(b)); c != 0 {
+			return (c > 0) == s.spec.descending
+		}
+		return a.Symbol < b.Symbol
+	}
+	if s.spec.descending {
+		return a.Symbol > b.Symbol
+	}
+	return a.Symbol < b.Symbol
+}
@@ -0,0 +1,119 @@
+package nepse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Store persists small pieces of state, such as the position of a floor sheet
+// tail or a candle builder, so a process can resume where it stopped after a
+// restart. Load of a key never saved fails with ErrNotFound.
+type Store interface {
+	Load(ctx context.Context, key string) ([]byte, error)
+	Save(ctx context.Context, key string, value []byte) error
+}
+
+// FileStore is a Store keeping each key in a file of a directory. Saves write a
+// temporary file and rename it, so a crash never leaves a partial value.
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore returns a store in dir, creating the directory if needed
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create store directory: %w", err)
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+// Load implements Store
+func (s *FileStore) Load(ctx context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	value, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, NewNotFoundError(fmt.Sprintf("stored key %q", key))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %q: %w", key, err)
+	}
+	return value, nil
+}
+
+// Save implements Store
+func (s *FileStore) Save(ctx context.Context, key string, value []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to save %q: %w", key, err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(value); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to save %q: %w", key, err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to save %q: %w", key, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to save %q: %w", key, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to save %q: %w", key, err)
+	}
+	return nil
+}
+
+// path returns the file of a key, escaped so any key is a valid file name
+func (s *FileStore) path(key string) (string, error) {
+	name := url.PathEscape(key)
+	if name == "" || name == "." || name == ".." || strings.HasPrefix(name, ".tmp-") {
+		return "", NewInvalidArgumentError(fmt.Sprintf("invalid store key %q", key))
+	}
+	return filepath.Join(s.dir, name), nil
+}
+
+// MemoryStore is an in-memory Store, for tests and short-lived processes
+type MemoryStore struct {
+	mu     sync.Mutex
+	values map[string][]byte
+}
+
+// NewMemoryStore returns an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{values: make(map[string][]byte)}
+}
+
+// Load implements Store
+func (s *MemoryStore) Load(ctx context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, ok := s.values[key]
+	if !ok {
+		return nil, NewNotFoundError(fmt.Sprintf("stored key %q", key))
+	}
+	return slices.Clone(value), nil
+}
+
+// Save implements Store
+func (s *MemoryStore) Save(ctx context.Context, key string, value []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values[key] = slices.Clone(value)
+	return nil
+}
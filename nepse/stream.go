@@ -0,0 +1,220 @@
+package nepse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// NEPSE has no push feed, so subscriptions poll a REST endpoint on an interval
+// and deliver results on a channel. Polling goes through the client's stream
+// Transport (PollingTransport unless replaced) and its rate limiter; failed
+// polls back off exponentially (honouring Retry-After on rate limit errors) and
+// resume the normal interval after the next success. Channels are closed once
+// the context is done.
+
+// maxPollBackoff caps the delay between failed polls
+const maxPollBackoff = 5 * time.Minute
+
+// LiveMarketUpdate is one poll of the live market: a snapshot of every entry,
+// or the error that poll failed with
+type LiveMarketUpdate struct {
+	Entries []LiveMarketEntry
+	Time    time.Time
+	Err     error
+}
+
+// SubscribeLiveMarket polls the live market every interval and sends each
+// snapshot, or poll error, on the returned channel until ctx is done:
+//
+//	updates, err := client.SubscribeLiveMarket(ctx, 5*time.Second)
+//	for u := range updates {
+//		if u.Err != nil {
+//			log.Println(u.Err)
+//			continue
+//		}
+//		render(u.Entries)
+//	}
+//
+// By default a slow receiver delays the next poll rather than dropping
+// snapshots; see WithBackpressure.
+func (h *HTTPClient) SubscribeLiveMarket(ctx context.Context, interval time.Duration) (<-chan LiveMarketUpdate, error) {
+	if err := validateInterval(interval); err != nil {
+		return nil, err
+	}
+
+	updates := newOutbox[LiveMarketUpdate](h, nil)
+	go func() {
+		defer updates.close()
+		feed := Feed{Kind: FeedLiveMarket, Interval: interval}
+		fetch := func(ctx context.Context) ([]LiveMarketEntry, error) {
+			return h.GetLiveMarket(ctx)
+		}
+		streamFeed(ctx, h.streamTransport(), feed, fetch, func(ctx context.Context, entries []LiveMarketEntry, err error) error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			updates.send(ctx, LiveMarketUpdate{Entries: entries, Time: time.Now(), Err: err})
+			return err
+		})
+	}()
+	return updates.ch, nil
+}
+
+// TodayPricesUpdate is one poll of today's prices: the rows that changed since
+// the previous poll, or the error the poll failed with
+type TodayPricesUpdate struct {
+	TodayPricesDiff
+	Time time.Time
+	Err  error
+}
+
+// SubscribeTodaysPrices polls the latest session's price table every interval
+// and sends only the rows that changed, as computed by DiffTodayPrices. The
+// first update holds the whole table; polls with no change send nothing.
+func (h *HTTPClient) SubscribeTodaysPrices(ctx context.Context, interval time.Duration) (<-chan TodayPricesUpdate, error) {
+	if err := validateInterval(interval); err != nil {
+		return nil, err
+	}
+
+	updates := newOutbox(h, mergeTodayPrices)
+	go func() {
+		defer updates.close()
+		var last []TodayPrice
+		feed := Feed{Kind: FeedTodayPrices, Interval: interval}
+		fetch := func(ctx context.Context) ([]TodayPrice, error) {
+			return h.GetTodaysPrices(ctx, "")
+		}
+		streamFeed(ctx, h.streamTransport(), feed, fetch, func(ctx context.Context, prices []TodayPrice, err error) error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err != nil {
+				updates.send(ctx, TodayPricesUpdate{Time: time.Now(), Err: err})
+				return err
+			}
+			diff := DiffTodayPrices(last, prices)
+			if last != nil && diff.Empty() {
+				return nil
+			}
+			if updates.send(ctx, TodayPricesUpdate{TodayPricesDiff: diff, Time: time.Now()}) {
+				last = prices
+			}
+			return nil
+		})
+	}()
+	return updates.ch, nil
+}
+
+// SubscribeQuote polls the quote of one security every interval and sends it
+// whenever it changes; unchanged ticks are dropped. Quotes come from the live
+// market while the security trades, and from today's prices otherwise. The
+// symbol is resolved up front, so an unknown symbol fails immediately. Poll
+// errors are logged and retried with backoff.
+//
+// All quote subscriptions of a client share one polling loop, running at the
+// shortest subscribed interval: each tick is a single live market request, plus
+// a today's prices snapshot every few minutes while some subscribed security is
+// not trading, however many symbols are subscribed. A subscription joining a
+// running loop gets its first quote on the next tick. A slow receiver only ever
+// misses intermediate quotes of its own security.
+func (h *HTTPClient) SubscribeQuote(ctx context.Context, symbol string, interval time.Duration) (<-chan Quote, error) {
+	if err := validateInterval(interval); err != nil {
+		return nil, err
+	}
+	security, err := h.findSecurityBySymbol(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find security %s: %w", symbol, err)
+	}
+
+	sub := &quoteSub{
+		security: *security,
+		interval: interval,
+		latest:   &outbox[Quote]{ch: make(chan Quote, 1), policy: BackpressureCoalesce},
+	}
+	quotes := newOutbox[Quote](h, nil)
+	h.quoteHub.add(h, sub)
+	go func() {
+		defer quotes.close()
+		defer h.quoteHub.remove(h, sub)
+		var last Quote
+		sent := false
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case quote := <-sub.latest.ch:
+				if sent && sameTick(last, quote) {
+					continue
+				}
+				if quotes.send(ctx, quote) {
+					last, sent = quote, true
+				}
+			}
+		}
+	}()
+	return quotes.ch, nil
+}
+
+// sameTick reports whether two quotes carry the same market data, ignoring
+// when and from which endpoint they were fetched
+func sameTick(a, b Quote) bool {
+	a.AsOf, b.AsOf = Timestamp{}, Timestamp{}
+	a.Source, b.Source = "", ""
+	return a == b
+}
+
+// pollEvery calls poll every interval until ctx is done. After a failed poll the
+// next one is delayed by pollBackoff instead. A non-nil schedule stretches the
+// wait outside trading hours.
+func pollEvery(ctx context.Context, interval time.Duration, schedule *TradingHoursSchedule, poll func(context.Context) error) {
+	failures := 0
+	for {
+		wait := interval
+		if err := poll(ctx); err != nil {
+			failures++
+			wait = pollBackoff(interval, failures, err)
+		} else {
+			failures = 0
+		}
+		if schedule != nil && ctx.Err() == nil {
+			wait = max(wait, schedule.Wait(ctx, time.Now(), interval))
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// pollBackoff returns the delay after the given number of consecutive failed
+// polls: the interval doubled per failure, capped at maxPollBackoff, and no less
+// than the server's Retry-After
+func pollBackoff(interval time.Duration, failures int, err error) time.Duration {
+	wait := interval
+	for i := 0; i < failures && wait < maxPollBackoff; i++ {
+		wait *= 2
+	}
+	wait = min(wait, maxPollBackoff)
+
+	var nepseErr *NepseError
+	if errors.As(err, &nepseErr) && nepseErr.RetryAfter > wait {
+		wait = nepseErr.RetryAfter
+	}
+	return wait
+}
+
+// sendUpdate delivers v unless ctx is done first, reporting whether it was sent
+func sendUpdate[T any](ctx context.Context, ch chan<- T, v T) bool {
+	select {
+	case ch <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,176 @@
+package nepse
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultSymbolCacheTTL is how long the security list behind symbol lookups is
+// reused by clients created with DefaultOptions
+const DefaultSymbolCacheTTL = 30 * time.Minute
+
+// symbolIndex caches the security list keyed by symbol and ID, so symbol
+// resolution is an in-memory lookup instead of a full list download per call
+type symbolIndex struct {
+	mu       sync.RWMutex
+	snapshot *symbolSnapshot
+	loads    singleflight.Group
+}
+
+// symbolSnapshot is one immutable load of the security list
+type symbolSnapshot struct {
+	securities []Security
+	bySymbol   map[string]int
+	byID       map[int32]int
+	loadedAt   time.Time
+}
+
+func newSymbolSnapshot(securities []Security) *symbolSnapshot {
+	s := &symbolSnapshot{
+		securities: securities,
+		bySymbol:   make(map[string]int, len(securities)),
+		byID:       make(map[int32]int, len(securities)),
+		loadedAt:   time.Now(),
+	}
+	for i, security := range securities {
+		s.bySymbol[security.Symbol] = i
+		s.byID[security.ID] = i
+	}
+	return s
+}
+
+// lookupSymbol returns a copy of the security with a normalized symbol
+func (s *symbolSnapshot) lookupSymbol(symbol string) (*Security, bool) {
+	i, ok := s.bySymbol[symbol]
+	if !ok {
+		return nil, false
+	}
+	security := s.securities[i]
+	return &security, true
+}
+
+// lookupID returns a copy of the security with an ID
+func (s *symbolSnapshot) lookupID(id int32) (*Security, bool) {
+	i, ok := s.byID[id]
+	if !ok {
+		return nil, false
+	}
+	security := s.securities[i]
+	return &security, true
+}
+
+// RefreshSymbols reloads the security list behind symbol lookups, e.g. after a
+// new listing, without waiting for the cache to expire
+func (h *HTTPClient) RefreshSymbols(ctx context.Context) error {
+	_, err := h.loadSymbols(ctx)
+	return err
+}
+
+// symbols returns the cached security index, loading it if it is missing or
+// older than Options.SymbolCacheTTL. With a TTL of zero every call reloads.
+func (h *HTTPClient) symbols(ctx context.Context) (*symbolSnapshot, error) {
+	if ttl := h.options.SymbolCacheTTL; ttl > 0 {
+		h.symbolIndex.mu.RLock()
+		snapshot := h.symbolIndex.snapshot
+		h.symbolIndex.mu.RUnlock()
+		if snapshot != nil && time.Since(snapshot.loadedAt) < ttl {
+			recordCacheHit(ctx)
+			return snapshot, nil
+		}
+	}
+	return h.loadSymbols(ctx)
+}
+
+// loadSymbols downloads the security list and replaces the index. Concurrent
+// loads share one request.
+func (h *HTTPClient) loadSymbols(ctx context.Context) (*symbolSnapshot, error) {
+	v, err, _ := h.symbolIndex.loads.Do("", func() (any, error) {
+		securities, err := h.GetSecurityList(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get security list: %w", err)
+		}
+		snapshot := newSymbolSnapshot(securities)
+		if h.options.SymbolCacheTTL > 0 {
+			h.symbolIndex.mu.Lock()
+			h.symbolIndex.snapshot = snapshot
+			h.symbolIndex.mu.Unlock()
+		}
+		return snapshot, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*symbolSnapshot), nil
+}
+
+// SymbolErrors reports the symbols ResolveSymbols could not resolve, keyed by the
+// symbol as the caller passed it. errors.Is matches the sentinel of any entry, e.g.
+// errors.Is(err, ErrNotFound).
+type SymbolErrors map[string]error
+
+func (e SymbolErrors) Error() string {
+	symbols := make([]string, 0, len(e))
+	for symbol := range e {
+		symbols = append(symbols, symbol)
+	}
+	slices.Sort(symbols)
+	if len(symbols) == 1 {
+		return e[symbols[0]].Error()
+	}
+	return fmt.Sprintf("%d symbols could not be resolved: %s", len(symbols), strings.Join(symbols, ", "))
+}
+
+// Unwrap returns the per-symbol errors
+func (e SymbolErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// ResolveSymbols maps many symbols to security IDs with a single security list
+// lookup. The map is keyed by the symbols as passed and holds every symbol that
+// resolved; if any did not, the error is a SymbolErrors describing each of them,
+// so callers such as watchlist importers can keep the partial result:
+//
+//	ids, err := client.ResolveSymbols(ctx, []string{"NABIL", "NICA", "XYZ"})
+//	var unknown nepse.SymbolErrors
+//	if errors.As(err, &unknown) {
+//		log.Printf("skipping %d unknown symbols", len(unknown))
+//	} else if err != nil {
+//		return err
+//	}
+func (h *HTTPClient) ResolveSymbols(ctx context.Context, symbols []string) (map[string]int32, error) {
+	index, err := h.symbols(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]int32, len(symbols))
+	var failed SymbolErrors
+	for _, symbol := range symbols {
+		normalized, err := normalizeSymbolArg(symbol)
+		if err == nil {
+			if security, ok := index.lookupSymbol(normalized); ok {
+				ids[symbol] = security.ID
+				continue
+			}
+			err = newSymbolNotFoundError(normalized, index.securities)
+		}
+		if failed == nil {
+			failed = make(SymbolErrors)
+		}
+		failed[symbol] = err
+	}
+	if failed != nil {
+		return ids, failed
+	}
+	return ids, nil
+}
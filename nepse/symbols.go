@@ -0,0 +1,119 @@
+package nepse
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// maxSymbolLength is the longest symbol ValidateSymbol accepts. NEPSE symbols are
+// short tickers, debenture symbols with a maturity year being the longest.
+const maxSymbolLength = 20
+
+// promoterSuffixes are the suffixes NEPSE appends to promoter share symbols
+var promoterSuffixes = []string{"PO", "P"}
+
+// NormalizeSymbol canonicalises a user-supplied symbol: it trims and uppercases it,
+// and joins a separated promoter suffix ("nabil po", "NABIL-P") onto the symbol.
+func NormalizeSymbol(symbol string) string {
+	s := strings.ToUpper(strings.TrimSpace(symbol))
+	for _, suffix := range promoterSuffixes {
+		for _, sep := range []string{" ", "-", "_", "."} {
+			if base, ok := strings.CutSuffix(s, sep+suffix); ok && base != "" {
+				return strings.TrimSpace(base) + suffix
+			}
+		}
+	}
+	return s
+}
+
+// ValidateSymbol checks that a normalized symbol is non-empty, at most 20
+// characters long and made only of letters and digits
+func ValidateSymbol(symbol string) error {
+	if symbol == "" {
+		return NewInvalidClientRequestError("symbol cannot be empty")
+	}
+	if len(symbol) > maxSymbolLength {
+		return NewInvalidClientRequestError(fmt.Sprintf("symbol %q is longer than %d characters", symbol, maxSymbolLength))
+	}
+	for _, r := range symbol {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return NewInvalidClientRequestError(fmt.Sprintf("symbol %q contains invalid character %q", symbol, r))
+		}
+	}
+	return nil
+}
+
+// normalizeSymbolArg normalizes and validates a symbol argument
+func normalizeSymbolArg(symbol string) (string, error) {
+	s := NormalizeSymbol(symbol)
+	if err := ValidateSymbol(s); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+// newSymbolNotFoundError reports an unknown symbol, suggesting close matches
+func newSymbolNotFoundError(symbol string, securities []Security) *NepseError {
+	suggestions := suggestSymbols(securities, symbol, 3)
+	if len(suggestions) == 0 {
+		return NewNotFoundError("security with symbol " + symbol)
+	}
+	return NewNepseError(ErrorTypeNotFound,
+		fmt.Sprintf("security with symbol %s not found (did you mean %s?)", symbol, strings.Join(suggestions, ", ")), nil)
+}
+
+// suggestSymbols returns up to n symbols close to symbol: those within a small edit
+// distance, and those sharing it as a prefix (e.g. its promoter share), closest first
+func suggestSymbols(securities []Security, symbol string, n int) []string {
+	type candidate struct {
+		symbol   string
+		distance int
+	}
+	maxDistance := 1 + len(symbol)/4
+
+	var candidates []candidate
+	for _, s := range securities {
+		d := editDistance(symbol, s.Symbol)
+		if d > maxDistance && !strings.HasPrefix(s.Symbol, symbol) && !strings.HasPrefix(symbol, s.Symbol) {
+			continue
+		}
+		candidates = append(candidates, candidate{s.Symbol, d})
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].symbol < candidates[j].symbol
+	})
+
+	var out []string
+	for _, c := range candidates {
+		if len(out) == n {
+			break
+		}
+		out = append(out, c.symbol)
+	}
+	return out
+}
+
+// editDistance returns the Levenshtein distance between two ASCII strings
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
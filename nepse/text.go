@@ -0,0 +1,198 @@
+package nepse
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Company names and announcements mix Devanagari and Latin text, and the same
+// Devanagari word can arrive in different Unicode forms (a precomposed nukta
+// letter or base letter plus nukta). NormalizeText brings text to NFC so equal
+// strings compare equal; Transliterate and SearchKey give a Latin form for
+// search and deduplication.
+
+// Devanagari code points handled by the normalizer and transliterator
+const (
+	devanagariNukta  = '़'
+	devanagariVirama = '्'
+)
+
+// nfcDecompose maps the Devanagari nukta letters that NFC keeps decomposed
+// (composition exclusions U+0958-U+095F) to their base letters
+var nfcDecompose = map[rune]rune{
+	'\u0958': '\u0915', // qa
+	'\u0959': '\u0916', // khha
+	'\u095a': '\u0917', // ghha
+	'\u095b': '\u091c', // za
+	'\u095c': '\u0921', // dddha
+	'\u095d': '\u0922', // rha
+	'\u095e': '\u092b', // fa
+	'\u095f': '\u092f', // yya
+}
+
+// nfcCompose maps the base letters NFC composes with a following nukta
+var nfcCompose = map[rune]rune{
+	'\u0928': '\u0929', // nnna
+	'\u0930': '\u0931', // rra
+	'\u0933': '\u0934', // llla
+}
+
+// NormalizeText returns s in Unicode NFC for Devanagari, with invisible
+// zero-width spaces and byte order marks removed and runs of whitespace collapsed
+// to a single space. Latin text is left as is.
+func NormalizeText(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	space := false
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\u200b' || r == '\ufeff':
+			continue
+		case unicode.IsSpace(r):
+			space = b.Len() > 0
+			continue
+		}
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+
+		if base, ok := nfcDecompose[r]; ok {
+			b.WriteRune(base)
+			b.WriteRune(devanagariNukta)
+			continue
+		}
+		if composed, ok := nfcCompose[r]; ok && i+1 < len(runes) && runes[i+1] == devanagariNukta {
+			b.WriteRune(composed)
+			i++
+			continue
+		}
+		// Canonical order puts the nukta (ccc 7) before the virama (ccc 9)
+		if r == devanagariVirama && i+1 < len(runes) && runes[i+1] == devanagariNukta {
+			b.WriteRune(devanagariNukta)
+			b.WriteRune(devanagariVirama)
+			i++
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// devanagariVowels are the independent vowels
+var devanagariVowels = map[rune]string{
+	'अ': "a", 'आ': "a", 'इ': "i", 'ई': "i", 'उ': "u", 'ऊ': "u", 'ऋ': "ri",
+	'ए': "e", 'ऐ': "ai", 'ओ': "o", 'औ': "au",
+}
+
+// devanagariVowelSigns are the dependent vowel signs (matras)
+var devanagariVowelSigns = map[rune]string{
+	'ा': "a", 'ि': "i", 'ी': "i", 'ु': "u", 'ू': "u", 'ृ': "ri",
+	'े': "e", 'ै': "ai", 'ो': "o", 'ौ': "au",
+}
+
+// devanagariConsonants romanise consonants the way Nepali names are usually
+// spelled in Latin script
+var devanagariConsonants = map[rune]string{
+	'क': "k", 'ख': "kh", 'ग': "g", 'घ': "gh", 'ङ': "ng",
+	'च': "ch", 'छ': "chh", 'ज': "j", 'झ': "jh", 'ञ': "n",
+	'ट': "t", 'ठ': "th", 'ड': "d", 'ढ': "dh", 'ण': "n",
+	'त': "t", 'थ': "th", 'द': "d", 'ध': "dh", 'न': "n",
+	'प': "p", 'फ': "ph", 'ब': "b", 'भ': "bh", 'म': "m",
+	'य': "y", 'र': "r", 'ल': "l", 'व': "b", 'श': "sh",
+	'ष': "sh", 'स': "s", 'ह': "h",
+	'ऩ': "n", 'ऱ': "r", 'ऴ': "l",
+}
+
+// devanagariNuktaConsonants override a consonant followed by a nukta
+var devanagariNuktaConsonants = map[rune]string{
+	'क': "q", 'ख': "kh", 'ग': "g", 'ज': "z", 'ड': "r", 'ढ': "rh", 'फ': "f", 'य': "y",
+}
+
+// devanagariSigns are the remaining marks, punctuation and digits
+var devanagariSigns = map[rune]string{
+	'ं': "n", 'ँ': "n", 'ः': "h", 'ऽ': "", '।': ".", '॥': ".",
+	'०': "0", '१': "1", '२': "2", '३': "3", '४': "4",
+	'५': "5", '६': "6", '७': "7", '८': "8", '९': "9",
+}
+
+// Transliterate romanises the Devanagari in s into lowercase ASCII, leaving other
+// text unchanged: "नबिल बैंक लिमिटेड" becomes "nabil baink limited". The inherent
+// vowel is dropped at the end of a word, as in spoken Nepali; it is a phonetic
+// spelling for matching, not a reversible scheme.
+func Transliterate(s string) string {
+	runes := []rune(NormalizeText(s))
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if latin, ok := devanagariVowels[r]; ok {
+			b.WriteString(latin)
+			continue
+		}
+		if latin, ok := devanagariSigns[r]; ok {
+			b.WriteString(latin)
+			continue
+		}
+		if r == devanagariVirama || r == devanagariNukta || devanagariVowelSigns[r] != "" {
+			continue // stray marks without a consonant
+		}
+		latin, ok := devanagariConsonants[r]
+		if !ok {
+			b.WriteRune(r)
+			continue
+		}
+
+		wordStart := i == 0 || !isDevanagariLetter(runes[i-1])
+		// ज्ञ is pronounced and spelled "gy"
+		if r == 'ज' && i+2 < len(runes) && runes[i+1] == devanagariVirama && runes[i+2] == 'ञ' {
+			latin = "gy"
+			i += 2
+		} else if i+1 < len(runes) && runes[i+1] == devanagariNukta {
+			if nukta, ok := devanagariNuktaConsonants[r]; ok {
+				latin = nukta
+			}
+			i++
+		}
+		b.WriteString(latin)
+
+		switch {
+		case i+1 < len(runes) && runes[i+1] == devanagariVirama:
+			i++
+		case i+1 < len(runes) && devanagariVowelSigns[runes[i+1]] != "":
+			b.WriteString(devanagariVowelSigns[runes[i+1]])
+			i++
+		case wordStart || (i+1 < len(runes) && isDevanagariLetter(runes[i+1])):
+			b.WriteByte('a')
+		}
+	}
+	return b.String()
+}
+
+// isDevanagariLetter reports whether r continues a Devanagari word (letters,
+// vowel signs and marks, but not digits or punctuation)
+func isDevanagariLetter(r rune) bool {
+	return r >= 'ऀ' && r <= 'ॣ'
+}
+
+// SearchKey folds text to a form for searching and deduplicating names: NFC
+// normalized, transliterated, lowercased, with punctuation dropped and words
+// separated by single spaces. "NABIL BANK LTD." and "Nabil Bank Ltd" share a key.
+func SearchKey(s string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(Transliterate(s)) {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			space = b.Len() > 0
+			continue
+		}
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,100 @@
+package nepse
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Timestamp is a NEPSE date or date-time decoded into a time.Time in NepalLocation.
+// Raw keeps the string exactly as NEPSE sent it; it is what gets marshaled back.
+type Timestamp struct {
+	time.Time
+	Raw string
+}
+
+// timestampLayouts are the date/time layouts seen in NEPSE responses
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	DateTimeFormat,
+	"2006-01-02 15:04:05.999999999",
+	DateFormat,
+}
+
+// ParseTimestamp parses a NEPSE date, date-time or epoch (seconds or milliseconds)
+// string. Values without a zone are taken as Nepal time.
+func ParseTimestamp(s string) (Timestamp, error) {
+	if s == "" {
+		return Timestamp{}, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		t := time.Unix(n, 0)
+		if n > 1e12 {
+			t = time.UnixMilli(n)
+		}
+		return Timestamp{Time: t.In(NepalLocation), Raw: s}, nil
+	}
+	for _, layout := range timestampLayouts {
+		if t, err := time.ParseInLocation(layout, s, NepalLocation); err == nil {
+			return Timestamp{Time: t.In(NepalLocation), Raw: s}, nil
+		}
+	}
+	return Timestamp{Raw: s}, fmt.Errorf("unrecognized NEPSE timestamp %q", s)
+}
+
+// Date returns the calendar date in YYYY-MM-DD form, or "" if t is zero
+func (t Timestamp) Date() string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(DateFormat)
+}
+
+// String returns the raw value NEPSE sent, or the formatted time if there is none
+func (t Timestamp) String() string {
+	if t.Raw != "" || t.IsZero() {
+		return t.Raw
+	}
+	return t.Format(DateTimeFormat)
+}
+
+// MarshalJSON encodes the raw value so responses round-trip unchanged;
+// epoch values are written back as numbers
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.Raw != "" && isDigits(t.Raw) {
+		return []byte(t.Raw), nil
+	}
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON accepts a string or an epoch number. Unrecognized layouts are
+// kept in Raw with a zero Time rather than failing the whole response.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*t = Timestamp{}
+		return nil
+	}
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+	*t, _ = ParseTimestamp(s)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler consistently with MarshalJSON
+func (t Timestamp) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (t *Timestamp) UnmarshalText(text []byte) error {
+	*t, _ = ParseTimestamp(string(text))
+	return nil
+}
@@ -0,0 +1,117 @@
+package nepse
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// FeedKind names a stream of market data a subscription is built on. The
+// comment of each kind gives the payload type a Transport must deliver for it.
+type FeedKind string
+
+const (
+	// FeedLiveMarket delivers []LiveMarketEntry
+	FeedLiveMarket FeedKind = "live-market"
+
+	// FeedTodayPrices delivers []TodayPrice of the latest session
+	FeedTodayPrices FeedKind = "today-prices"
+
+	// FeedQuote delivers map[string]Quote keyed by symbol, covering at least
+	// the securities of the client's SubscribeQuote calls
+	FeedQuote FeedKind = "quote"
+
+	// FeedMarketDepth delivers *MarketDepth; Key is the security ID
+	FeedMarketDepth FeedKind = "market-depth"
+
+	// FeedFloorSheet delivers []FloorSheetEntry of trades not delivered before,
+	// in ascending contract order; Key is the AD business date
+	FeedFloorSheet FeedKind = "floor-sheet"
+
+	// FeedWatchlist delivers map[string]Quote keyed by symbol; Key is the
+	// comma-separated symbols
+	FeedWatchlist FeedKind = "watchlist"
+)
+
+// Feed identifies what a subscription streams and how often it wants updates
+type Feed struct {
+	Kind     FeedKind
+	Key      string
+	Interval time.Duration
+}
+
+// FetchFunc fetches the current payload of a feed over the REST API
+type FetchFunc func(ctx context.Context) (any, error)
+
+// DeliverFunc hands a feed payload, or the error getting it failed with, to the
+// subscription. A non-nil return means the update failed and the transport
+// should back off before trying again.
+type DeliverFunc func(ctx context.Context, payload any, err error) error
+
+// Transport carries the feeds subscriptions are built on. NEPSE only offers
+// REST today, so the default is PollingTransport; a push implementation (a
+// WebSocket or SSE feed, should NEPSE ever publish one) can replace it with
+// WithStreamTransport without any change to code consuming subscriptions.
+//
+// Stream runs until ctx is done. It calls deliver with each payload, typed as
+// documented on feed.Kind, and may call fetch whenever it needs the REST
+// snapshot, e.g. on every tick, to seed a push feed, or after a reconnect.
+type Transport interface {
+	Stream(ctx context.Context, feed Feed, fetch FetchFunc, deliver DeliverFunc)
+}
+
+// PollingTransport streams feeds by calling fetch every feed interval. Failed
+// polls back off exponentially (honouring Retry-After on rate limit errors) and
+// the normal interval resumes after the next success.
+type PollingTransport struct {
+	// Schedule, if set, slows polling down outside trading hours
+	Schedule *TradingHoursSchedule
+}
+
+// Stream implements Transport
+func (t PollingTransport) Stream(ctx context.Context, feed Feed, fetch FetchFunc, deliver DeliverFunc) {
+	pollEvery(ctx, feed.Interval, t.Schedule, func(ctx context.Context) error {
+		payload, err := fetch(ctx)
+		return deliver(ctx, payload, err)
+	})
+}
+
+// transporter is implemented by clients that carry a stream transport
+type transporter interface {
+	streamTransport() Transport
+}
+
+// streamTransport returns the configured transport, by default a
+// PollingTransport on the client's trading hours schedule
+func (h *HTTPClient) streamTransport() Transport {
+	if h.options.StreamTransport != nil {
+		return h.options.StreamTransport
+	}
+	return PollingTransport{Schedule: h.tradingHoursSchedule()}
+}
+
+// transportOf returns the stream transport of client, or PollingTransport if
+// client has none
+func transportOf(client any) Transport {
+	if t, ok := client.(transporter); ok {
+		return t.streamTransport()
+	}
+	return PollingTransport{}
+}
+
+// streamFeed runs feed on t with typed fetch and handle functions. A payload of
+// the wrong type, which only a misbehaving push transport can produce, is
+// handled as an invalid server response.
+func streamFeed[T any](ctx context.Context, t Transport, feed Feed, fetch func(context.Context) (T, error), handle func(context.Context, T, error) error) {
+	t.Stream(ctx, feed,
+		func(ctx context.Context) (any, error) {
+			return fetch(ctx)
+		},
+		func(ctx context.Context, payload any, err error) error {
+			v, ok := payload.(T)
+			if !ok && err == nil {
+				err = NewInvalidServerResponseError(fmt.Sprintf("%s feed delivered %T, want %T", feed.Kind, payload, v))
+			}
+			return handle(ctx, v, err)
+		})
+}
@@ -1,9 +1,33 @@
 package nepse
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
 // MarketSummaryItem represents a single item in the market summary response
 type MarketSummaryItem struct {
 	Detail string  `json:"detail"`
 	Value  float64 `json:"value"`
+
+	exact Money // Value decoded exactly, for the MarketSummary Money accessors
+}
+
+// UnmarshalJSON decodes a summary item, keeping its value exactly as published
+func (i *MarketSummaryItem) UnmarshalJSON(data []byte) error {
+	type marketSummaryItem MarketSummaryItem
+	if err := json.Unmarshal(data, (*marketSummaryItem)(i)); err != nil {
+		return err
+	}
+	var exact struct {
+		Value Money `json:"value"`
+	}
+	if json.Unmarshal(data, &exact) == nil {
+		i.exact = exact.Value
+	}
+	return nil
 }
 
 // MarketSummary represents the processed market summary data
@@ -14,78 +38,128 @@ type MarketSummary struct {
 	TotalScripsTraded         float64
 	TotalMarketCapitalization float64
 	TotalFloatMarketCap       float64
+
+	// exact amounts backing TurnoverMoney and MarketCapMoney
+	turnoverMoney, marketCapMoney Money
 }
 
 // MarketStatus represents the current market status
 type MarketStatus struct {
-	IsOpen string `json:"isOpen"` // API returns "OPEN" or "CLOSE" string, not boolean
-	AsOf   string `json:"asOf"`
-	ID     int32  `json:"id"`
+	IsOpen MarketState `json:"isOpen"` // API returns "OPEN" or "CLOSE" string, not boolean
+	AsOf   Timestamp   `json:"asOf"`
+	ID     int32       `json:"id"`
 }
 
 // IsMarketOpen returns true if the market is currently open
 func (m *MarketStatus) IsMarketOpen() bool {
-	return m.IsOpen == "OPEN"
+	return m.IsOpen == MarketStateOpen
 }
 
 // NepseIndexRaw represents the raw NEPSE index response item
 type NepseIndexRaw struct {
-	ID               int32   `json:"id"`
-	Index            string  `json:"index"`
-	Close            float64 `json:"close"`
-	High             float64 `json:"high"`
-	Low              float64 `json:"low"`
-	PreviousClose    float64 `json:"previousClose"`
-	Change           float64 `json:"change"`
-	PerChange        float64 `json:"perChange"`
-	FiftyTwoWeekHigh float64 `json:"fiftyTwoWeekHigh"`
-	FiftyTwoWeekLow  float64 `json:"fiftyTwoWeekLow"`
-	CurrentValue     float64 `json:"currentValue"`
-	GeneratedTime    string  `json:"generatedTime"`
+	ID               int32     `json:"id"`
+	Index            string    `json:"index"`
+	Close            float64   `json:"close"`
+	High             float64   `json:"high"`
+	Low              float64   `json:"low"`
+	PreviousClose    float64   `json:"previousClose"`
+	Change           float64   `json:"change"`
+	PerChange        float64   `json:"perChange"`
+	FiftyTwoWeekHigh float64   `json:"fiftyTwoWeekHigh"`
+	FiftyTwoWeekLow  float64   `json:"fiftyTwoWeekLow"`
+	CurrentValue     float64   `json:"currentValue"`
+	GeneratedTime    Timestamp `json:"generatedTime"`
 }
 
 // NepseIndex represents the NEPSE main index (ID 58)
 type NepseIndex struct {
-	IndexValue       float64 `json:"close"`
-	PercentChange    float64 `json:"perChange"`
-	PointChange      float64 `json:"change"`
-	High             float64 `json:"high"`
-	Low              float64 `json:"low"`
-	PreviousClose    float64 `json:"previousClose"`
-	FiftyTwoWeekHigh float64 `json:"fiftyTwoWeekHigh"`
-	FiftyTwoWeekLow  float64 `json:"fiftyTwoWeekLow"`
-	CurrentValue     float64 `json:"currentValue"`
-	GeneratedTime    string  `json:"generatedTime"`
+	IndexValue       float64   `json:"close"`
+	PercentChange    float64   `json:"perChange"`
+	PointChange      float64   `json:"change"`
+	High             float64   `json:"high"`
+	Low              float64   `json:"low"`
+	PreviousClose    float64   `json:"previousClose"`
+	FiftyTwoWeekHigh float64   `json:"fiftyTwoWeekHigh"`
+	FiftyTwoWeekLow  float64   `json:"fiftyTwoWeekLow"`
+	CurrentValue     float64   `json:"currentValue"`
+	GeneratedTime    Timestamp `json:"generatedTime"`
+}
+
+// MainIndices holds the four headline NEPSE indices. An index missing
+// from the response is left nil.
+type MainIndices struct {
+	Nepse          *NepseIndex `json:"nepse"`
+	Sensitive      *NepseIndex `json:"sensitive"`
+	Float          *NepseIndex `json:"float"`
+	SensitiveFloat *NepseIndex `json:"sensitiveFloat"`
 }
 
 // SubIndex represents a sector sub-index (uses same structure as NepseIndexRaw)
 type SubIndex struct {
-	ID               int32   `json:"id"`
-	Index            string  `json:"index"`
-	Close            float64 `json:"close"`
-	High             float64 `json:"high"`
-	Low              float64 `json:"low"`
-	PreviousClose    float64 `json:"previousClose"`
-	Change           float64 `json:"change"`
-	PerChange        float64 `json:"perChange"`
-	FiftyTwoWeekHigh float64 `json:"fiftyTwoWeekHigh"`
-	FiftyTwoWeekLow  float64 `json:"fiftyTwoWeekLow"`
-	CurrentValue     float64 `json:"currentValue"`
-	GeneratedTime    string  `json:"generatedTime"`
+	ID               int32     `json:"id"`
+	Index            string    `json:"index"`
+	Close            float64   `json:"close"`
+	High             float64   `json:"high"`
+	Low              float64   `json:"low"`
+	PreviousClose    float64   `json:"previousClose"`
+	Change           float64   `json:"change"`
+	PerChange        float64   `json:"perChange"`
+	FiftyTwoWeekHigh float64   `json:"fiftyTwoWeekHigh"`
+	FiftyTwoWeekLow  float64   `json:"fiftyTwoWeekLow"`
+	CurrentValue     float64   `json:"currentValue"`
+	GeneratedTime    Timestamp `json:"generatedTime"`
 }
 
 // Security represents a listed security/company
 type Security struct {
-	ID                   int32  `json:"id"`
-	Symbol               string `json:"symbol"`
-	SecurityName         string `json:"securityName"`
-	IsSuspended          bool   `json:"isSuspended"`
-	SectorName           string `json:"sectorName"`
-	Instrument           string `json:"instrument"`
-	RegulatoryCategoryID int32  `json:"regulatoryCategoryId"`
-	ShareGroupID         int32  `json:"shareGroupId"`
-	ActiveStatus         string `json:"activeStatus"`
-	ListingDate          string `json:"listingDate"`
+	ID                   int32          `json:"id"`
+	Symbol               string         `json:"symbol"`
+	SecurityName         string         `json:"securityName"`
+	IsSuspended          bool           `json:"isSuspended"`
+	SectorName           string         `json:"sectorName"`
+	Instrument           InstrumentType `json:"instrument"`
+	RegulatoryCategoryID int32          `json:"regulatoryCategoryId"`
+	ShareGroupID         int32          `json:"shareGroupId"`
+	ActiveStatus         string         `json:"activeStatus"`
+	ListingDate          string         `json:"listingDate"`
+	SuspensionReason     string         `json:"suspensionReason,omitempty"`
+	SuspendedSince       string         `json:"suspendedDate,omitempty"`
+}
+
+// IsTradable returns true if the security is active and not suspended or halted
+func (s *Security) IsTradable() bool {
+	return !s.IsSuspended && (s.ActiveStatus == "" || s.ActiveStatus == "A")
+}
+
+// SecurityListOptions controls which securities GetSecurityListWithOptions returns
+type SecurityListOptions struct {
+	// IncludeDelisted includes delisted securities (the full historical universe)
+	IncludeDelisted bool
+
+	// Instrument keeps only securities of this instrument type as ClassifyInstrument
+	// reports it (empty for all)
+	Instrument InstrumentType
+
+	// Sector keeps only securities in this sector (empty for all)
+	Sector string
+}
+
+// SecurityFilter selects securities for GetSecurityListFiltered. Zero fields
+// match everything.
+type SecurityFilter struct {
+	// Sector keeps securities in this sector, compared case-insensitively
+	Sector string
+
+	// InstrumentType keeps securities of this instrument, as classified by
+	// ClassifyInstrument (so promoter shares are told apart from equities)
+	InstrumentType InstrumentType
+
+	// ActiveOnly keeps securities that can currently be traded (see IsTradable)
+	ActiveOnly bool
+
+	// Search keeps securities whose symbol or name contains the text, ignoring
+	// case, punctuation and script, and orders them best match first
+	Search string
 }
 
 // Company represents company information (different from Security)
@@ -105,60 +179,153 @@ type Company struct {
 
 // TodayPrice represents today's price data for a security
 type TodayPrice struct {
-	ID                  int32   `json:"id"`
-	Symbol              string  `json:"symbol"`
-	SecurityName        string  `json:"securityName"`
-	OpenPrice           float64 `json:"openPrice"`
-	HighPrice           float64 `json:"highPrice"`
-	LowPrice            float64 `json:"lowPrice"`
-	ClosePrice          float64 `json:"closePrice"`
-	TotalTradedQuantity int64   `json:"totalTradedQuantity"`
-	TotalTradedValue    float64 `json:"totalTradedValue"`
-	PreviousClose       float64 `json:"previousClose"`
-	DifferenceRs        float64 `json:"differenceRs"`
-	PercentageChange    float64 `json:"percentageChange"`
-	TotalTrades         int32   `json:"totalTrades"`
-	BusinessDate        string  `json:"businessDate"`
-	SecurityID          int32   `json:"securityId"`
-	LastTradedPrice     float64 `json:"lastTradedPrice"`
-	MaxPrice            float64 `json:"maxPrice"`
-	MinPrice            float64 `json:"minPrice"`
+	ID                  int32         `json:"id"`
+	Symbol              string        `json:"symbol"`
+	SecurityName        string        `json:"securityName"`
+	OpenPrice           float64       `json:"openPrice"`
+	HighPrice           float64       `json:"highPrice"`
+	LowPrice            float64       `json:"lowPrice"`
+	ClosePrice          float64       `json:"closePrice"`
+	TotalTradedQuantity int64         `json:"totalTradedQuantity"`
+	TotalTradedValue    float64       `json:"totalTradedValue"`
+	PreviousClose       float64       `json:"previousClose"`
+	DifferenceRs        float64       `json:"differenceRs"`
+	PercentageChange    Null[float64] `json:"percentageChange"`
+	TotalTrades         int32         `json:"totalTrades"`
+	BusinessDate        Timestamp     `json:"businessDate"`
+	SecurityID          int32         `json:"securityId"`
+	LastTradedPrice     float64       `json:"lastTradedPrice"`
+	MaxPrice            float64       `json:"maxPrice"`
+	MinPrice            float64       `json:"minPrice"`
+
+	AverageTradedPrice    float64   `json:"averageTradedPrice"`
+	MarketCapitalization  float64   `json:"marketCapitalization"`
+	FiftyTwoWeekHigh      float64   `json:"fiftyTwoWeekHigh"`
+	FiftyTwoWeekLow       float64   `json:"fiftyTwoWeekLow"`
+	LastUpdatedPrice      float64   `json:"lastUpdatedPrice"`
+	LastUpdatedTime       Timestamp `json:"lastUpdatedTime"`
+	PreviousDayClosePrice float64   `json:"previousDayClosePrice"`
+
+	// exact amounts backing ClosePriceMoney, LastTradedPriceMoney and TurnoverMoney
+	closeMoney, lastTradedMoney, turnoverMoney Money
+}
+
+// UnmarshalJSON decodes a price row, filling PreviousClose, LastTradedPrice and the
+// change fields from the alternative names NEPSE publishes them under
+func (p *TodayPrice) UnmarshalJSON(data []byte) error {
+	type todayPrice TodayPrice
+	if err := json.Unmarshal(data, (*todayPrice)(p)); err != nil {
+		return err
+	}
+	if p.PreviousClose == 0 {
+		p.PreviousClose = p.PreviousDayClosePrice
+	}
+	if p.LastTradedPrice == 0 {
+		p.LastTradedPrice = p.LastUpdatedPrice
+	}
+
+	// Decode the money fields again from the JSON text, exactly; on failure the
+	// Money accessors convert the float64 fields instead
+	var exact struct {
+		ClosePrice       Money `json:"closePrice"`
+		LastTradedPrice  Money `json:"lastTradedPrice"`
+		LastUpdatedPrice Money `json:"lastUpdatedPrice"`
+		TotalTradedValue Money `json:"totalTradedValue"`
+	}
+	if json.Unmarshal(data, &exact) == nil {
+		if exact.LastTradedPrice == 0 {
+			exact.LastTradedPrice = exact.LastUpdatedPrice
+		}
+		p.closeMoney, p.lastTradedMoney, p.turnoverMoney = exact.ClosePrice, exact.LastTradedPrice, exact.TotalTradedValue
+	}
+	if p.DifferenceRs == 0 && p.PreviousClose != 0 && p.ClosePrice != 0 {
+		p.DifferenceRs = roundTo(p.ClosePrice-p.PreviousClose, 2)
+		if !p.PercentageChange.Valid {
+			p.PercentageChange = NullOf(roundTo(p.DifferenceRs/p.PreviousClose*100, 2))
+		}
+	}
+	return nil
 }
 
 // PriceHistory represents historical price data for a security
 type PriceHistory struct {
-	BusinessDate        string  `json:"businessDate"`
-	SecurityID          int32   `json:"securityId"`
-	Symbol              string  `json:"symbol"`
-	SecurityName        string  `json:"securityName"`
-	OpenPrice           float64 `json:"openPrice"`
-	HighPrice           float64 `json:"highPrice"`
-	LowPrice            float64 `json:"lowPrice"`
-	ClosePrice          float64 `json:"closingPrice"`
-	TotalTradedQuantity int64   `json:"totalTradedQuantity"`
-	TotalTradedValue    float64 `json:"totalTradedValue"`
-	TotalTrades         int32   `json:"totalTrades"`
-	PreviousClose       float64 `json:"previousClose"`
-	DifferenceRs        float64 `json:"differenceRs"`
-	PercentageChange    float64 `json:"percentageChange"`
+	BusinessDate        Timestamp     `json:"businessDate"`
+	SecurityID          int32         `json:"securityId"`
+	Symbol              string        `json:"symbol"`
+	SecurityName        string        `json:"securityName"`
+	OpenPrice           float64       `json:"openPrice"`
+	HighPrice           float64       `json:"highPrice"`
+	LowPrice            float64       `json:"lowPrice"`
+	ClosePrice          float64       `json:"closingPrice"`
+	TotalTradedQuantity int64         `json:"totalTradedQuantity"`
+	TotalTradedValue    float64       `json:"totalTradedValue"`
+	TotalTrades         int32         `json:"totalTrades"`
+	PreviousClose       float64       `json:"previousClose"`
+	DifferenceRs        float64       `json:"differenceRs"`
+	PercentageChange    Null[float64] `json:"percentageChange"`
 }
 
 // FloorSheetEntry represents a single floor sheet entry
 type FloorSheetEntry struct {
-	ContractID       int64   `json:"contractId"`
-	StockSymbol      string  `json:"stockSymbol"`
-	SecurityName     string  `json:"securityName"`
-	BuyerMemberID    int32   `json:"buyerMemberId"`
-	SellerMemberID   int32   `json:"sellerMemberId"`
-	ContractQuantity int64   `json:"contractQuantity"`
-	ContractRate     float64 `json:"contractRate"`
-	BusinessDate     string  `json:"businessDate"`
-	TradeTime        string  `json:"tradeTime"`
-	SecurityID       int32   `json:"securityId"`
-	ContractAmount   float64 `json:"contractAmount"`
-	BuyerBrokerName  string  `json:"buyerBrokerName"`
-	SellerBrokerName string  `json:"sellerBrokerName"`
-	TradeBookID      int64   `json:"tradeBookId"`
+	ContractID       int64     `json:"contractId"`
+	StockSymbol      string    `json:"stockSymbol"`
+	SecurityName     string    `json:"securityName"`
+	BuyerMemberID    int32     `json:"buyerMemberId"`
+	SellerMemberID   int32     `json:"sellerMemberId"`
+	ContractQuantity int64     `json:"contractQuantity"`
+	ContractRate     float64   `json:"contractRate"`
+	BusinessDate     Timestamp `json:"businessDate"`
+	TradeTime        string    `json:"tradeTime"`
+	SecurityID       int32     `json:"securityId"`
+	ContractAmount   float64   `json:"contractAmount"`
+	BuyerBrokerName  string    `json:"buyerBrokerName"`
+	SellerBrokerName string    `json:"sellerBrokerName"`
+	TradeBookID      int64     `json:"tradeBookId"`
+
+	// exact amounts backing RateMoney and AmountMoney
+	rateMoney, amountMoney Money
+}
+
+// UnmarshalJSON decodes a floor sheet row, keeping the contract rate and amount
+// exactly as published
+func (e *FloorSheetEntry) UnmarshalJSON(data []byte) error {
+	type floorSheetEntry FloorSheetEntry
+	if err := json.Unmarshal(data, (*floorSheetEntry)(e)); err != nil {
+		return err
+	}
+	var exact struct {
+		ContractRate   Money `json:"contractRate"`
+		ContractAmount Money `json:"contractAmount"`
+	}
+	if json.Unmarshal(data, &exact) == nil {
+		e.rateMoney, e.amountMoney = exact.ContractRate, exact.ContractAmount
+	}
+	return nil
+}
+
+// FloorSheetProgress reports how far GetFloorSheetAll has got
+type FloorSheetProgress struct {
+	Page       int32 // pages fetched so far
+	TotalPages int32
+	Rows       int // rows fetched so far
+	TotalRows  int64
+}
+
+// BlockTrade represents a large negotiated transaction executed outside
+// continuous floor trading
+type BlockTrade struct {
+	ID             int64     `json:"id"`
+	ContractID     int64     `json:"contractId"`
+	SecurityID     int32     `json:"securityId"`
+	Symbol         string    `json:"symbol"`
+	SecurityName   string    `json:"securityName"`
+	BuyerMemberID  int32     `json:"buyerMemberId"`
+	SellerMemberID int32     `json:"sellerMemberId"`
+	Quantity       int64     `json:"quantity"`
+	Rate           float64   `json:"rate"`
+	Amount         float64   `json:"amount"`
+	BusinessDate   Timestamp `json:"businessDate"`
+	TradeTime      string    `json:"tradeTime"`
 }
 
 // FloorSheetResponse represents the paginated floor sheet response
@@ -190,22 +357,86 @@ type MarketDepth struct {
 		Quantity int64   `json:"quantity"`
 		Orders   int32   `json:"orders"`
 	} `json:"sellDepth"`
+	TotalBuyQuantity  int64 `json:"totalBuyQty"`
+	TotalSellQuantity int64 `json:"totalSellQty"`
+}
+
+// SecuritySupplyDemand summarizes the pending buy (demand) and sell (supply)
+// orders of one security
+type SecuritySupplyDemand struct {
+	SecurityID        int32   `json:"securityId"`
+	Symbol            string  `json:"symbol"`
+	SecurityName      string  `json:"securityName"`
+	TotalBuyQuantity  int64   `json:"totalBuyQuantity"`
+	TotalSellQuantity int64   `json:"totalSellQuantity"`
+	BuyOrders         int32   `json:"buyOrders"`
+	SellOrders        int32   `json:"sellOrders"`
+	DemandSupplyRatio float64 `json:"demandSupplyRatio"`
 }
 
+// TopList identifies one of NEPSE's top lists
+type TopList string
+
+// Top lists accepted by GetTopList
+const (
+	TopGainers     TopList = "gainers"
+	TopLosers      TopList = "losers"
+	TopTrade       TopList = "trade"
+	TopTransaction TopList = "transaction"
+	TopTurnover    TopList = "turnover"
+)
+
 // TopListEntry represents entries in top gainers/losers/trades lists
 type TopListEntry struct {
-	Symbol              string  `json:"symbol"`
-	SecurityName        string  `json:"securityName"`
-	ClosePrice          float64 `json:"closePrice"`
-	PercentageChange    float64 `json:"percentageChange"`
-	DifferenceRs        float64 `json:"differenceRs"`
-	TotalTradedQuantity int64   `json:"totalTradedQuantity"`
-	TotalTradedValue    float64 `json:"totalTradedValue"`
-	TotalTrades         int32   `json:"totalTrades"`
-	HighPrice           float64 `json:"highPrice,omitempty"`
-	LowPrice            float64 `json:"lowPrice,omitempty"`
-	OpenPrice           float64 `json:"openPrice,omitempty"`
-	PreviousClose       float64 `json:"previousClose,omitempty"`
+	Symbol              string        `json:"symbol"`
+	SecurityName        string        `json:"securityName"`
+	ClosePrice          float64       `json:"closePrice"`
+	PercentageChange    Null[float64] `json:"percentageChange"`
+	DifferenceRs        float64       `json:"differenceRs"`
+	TotalTradedQuantity int64         `json:"totalTradedQuantity"`
+	TotalTradedValue    float64       `json:"totalTradedValue"`
+	TotalTrades         int32         `json:"totalTrades"`
+	HighPrice           float64       `json:"highPrice,omitempty"`
+	LowPrice            float64       `json:"lowPrice,omitempty"`
+	OpenPrice           float64       `json:"openPrice,omitempty"`
+	PreviousClose       float64       `json:"previousClose,omitempty"`
+
+	SecurityID      int32   `json:"securityId"`
+	LTP             float64 `json:"ltp,omitempty"`
+	LastTradedPrice float64 `json:"lastTradedPrice,omitempty"`
+	ClosingPrice    float64 `json:"closingPrice,omitempty"`
+	PointChange     float64 `json:"pointChange,omitempty"`
+	ShareTraded     int64   `json:"shareTraded,omitempty"`
+	Turnover        float64 `json:"turnover,omitempty"`
+}
+
+// UnmarshalJSON decodes a top list entry. Each top list names its columns
+// differently (ltp, closingPrice, pointChange, shareTraded, turnover...), so the
+// common fields ClosePrice, DifferenceRs, TotalTradedQuantity and TotalTradedValue
+// are filled from whichever the list published.
+func (e *TopListEntry) UnmarshalJSON(data []byte) error {
+	type topListEntry TopListEntry
+	if err := json.Unmarshal(data, (*topListEntry)(e)); err != nil {
+		return err
+	}
+	for _, price := range []float64{e.LTP, e.LastTradedPrice, e.ClosingPrice} {
+		if e.ClosePrice == 0 {
+			e.ClosePrice = price
+		}
+	}
+	if e.DifferenceRs == 0 {
+		e.DifferenceRs = e.PointChange
+	}
+	if e.TotalTradedQuantity == 0 {
+		e.TotalTradedQuantity = e.ShareTraded
+	}
+	if e.TotalTradedValue == 0 {
+		e.TotalTradedValue = e.Turnover
+	}
+	if e.PreviousClose == 0 && e.ClosePrice != 0 && e.DifferenceRs != 0 {
+		e.PreviousClose = roundTo(e.ClosePrice-e.DifferenceRs, 2)
+	}
+	return nil
 }
 
 // SupplyDemandEntry represents supply and demand data
@@ -221,8 +452,62 @@ type SupplyDemandEntry struct {
 
 // GraphDataPoint represents a single data point in graph data
 type GraphDataPoint struct {
-	Date  string  `json:"date"`
-	Value float64 `json:"value"`
+	Date  Timestamp `json:"date"`
+	Value float64   `json:"value"`
+}
+
+// UnmarshalJSON decodes a graph point from either a {"date", "value"} object or
+// the [epoch, value] pair NEPSE's charts use. Numeric strings are accepted for
+// the value, and epochs may be in seconds or milliseconds.
+func (p *GraphDataPoint) UnmarshalJSON(data []byte) error {
+	var value Float
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		var pair []json.RawMessage
+		if err := json.Unmarshal(trimmed, &pair); err != nil {
+			return err
+		}
+		if len(pair) < 2 {
+			return fmt.Errorf("graph point %s: want [time, value]", trimmed)
+		}
+		if err := p.Date.UnmarshalJSON(pair[0]); err != nil {
+			return err
+		}
+		if err := value.UnmarshalJSON(pair[1]); err != nil {
+			return err
+		}
+		p.Value = float64(value)
+		return nil
+	}
+
+	var point struct {
+		Date  Timestamp `json:"date"`
+		Value Float     `json:"value"`
+	}
+	if err := json.Unmarshal(data, &point); err != nil {
+		return err
+	}
+	p.Date, p.Value = point.Date, float64(point.Value)
+	return nil
+}
+
+// Candle is an OHLC bar starting at Time
+type Candle struct {
+	Time   time.Time `json:"time"`
+	Open   float64   `json:"open"`
+	High   float64   `json:"high"`
+	Low    float64   `json:"low"`
+	Close  float64   `json:"close"`
+	Volume float64   `json:"volume"`
+}
+
+// GraphOptions selects a session or time window for graph requests
+type GraphOptions struct {
+	// BusinessDate (YYYY-MM-DD) requests a previous session instead of today
+	BusinessDate string
+
+	// From and To, when non-zero, keep only points within the time range
+	From time.Time
+	To   time.Time
 }
 
 // GraphResponse represents graph data response
@@ -233,29 +518,82 @@ type GraphResponse struct {
 // CompanyDetailsRaw represents the raw nested company details response
 type CompanyDetailsRaw struct {
 	SecurityMcsData struct {
-		SecurityID          string  `json:"securityId"`
-		OpenPrice           float64 `json:"openPrice"`
-		HighPrice           float64 `json:"highPrice"`
-		LowPrice            float64 `json:"lowPrice"`
-		TotalTradeQuantity  int64   `json:"totalTradeQuantity"`
-		TotalTrades         int32   `json:"totalTrades"`
-		LastTradedPrice     float64 `json:"lastTradedPrice"`
-		PreviousClose       float64 `json:"previousClose"`
-		BusinessDate        string  `json:"businessDate"`
-		ClosePrice          float64 `json:"closePrice"`
-		FiftyTwoWeekHigh    float64 `json:"fiftyTwoWeekHigh"`
-		FiftyTwoWeekLow     float64 `json:"fiftyTwoWeekLow"`
-		LastUpdatedDateTime string  `json:"lastUpdatedDateTime"`
+		SecurityID          string    `json:"securityId"`
+		OpenPrice           float64   `json:"openPrice"`
+		HighPrice           float64   `json:"highPrice"`
+		LowPrice            float64   `json:"lowPrice"`
+		TotalTradeQuantity  int64     `json:"totalTradeQuantity"`
+		TotalTrades         int32     `json:"totalTrades"`
+		LastTradedPrice     float64   `json:"lastTradedPrice"`
+		PreviousClose       float64   `json:"previousClose"`
+		BusinessDate        Timestamp `json:"businessDate"`
+		ClosePrice          float64   `json:"closePrice"`
+		FiftyTwoWeekHigh    float64   `json:"fiftyTwoWeekHigh"`
+		FiftyTwoWeekLow     float64   `json:"fiftyTwoWeekLow"`
+		LastUpdatedDateTime Timestamp `json:"lastUpdatedDateTime"`
 	} `json:"securityMcsData"`
 	SecurityData struct {
-		ID               int32  `json:"id"`
-		Symbol           string `json:"symbol"`
-		SecurityName     string `json:"securityName"`
-		ActiveStatus     string `json:"activeStatus"`
-		PermittedToTrade string `json:"permittedToTrade"`
-		Email            string `json:"email"`
-		Sector           string `json:"sector"`
+		ID               int32         `json:"id"`
+		Symbol           string        `json:"symbol"`
+		SecurityName     string        `json:"securityName"`
+		ActiveStatus     string        `json:"activeStatus"`
+		PermittedToTrade string        `json:"permittedToTrade"`
+		Email            string        `json:"email"`
+		Sector           string        `json:"sector"`
+		ISIN             string        `json:"isin"`
+		ListingDate      string        `json:"listingDate"`
+		TradingStartDate string        `json:"tradingStartDate"`
+		FaceValue        float64       `json:"faceValue"`
+		TickSize         float64       `json:"tickSize"`
+		IsPromoter       string        `json:"isPromoter"`
+		CreditRating     string        `json:"creditRating"`
+		CashDividend     Null[float64] `json:"cashDividend"`
+		BonusShare       Null[float64] `json:"bonusShare"`
+		ShareRegistrar   struct {
+			ID            int32  `json:"id"`
+			Name          string `json:"registrarName"`
+			Address       string `json:"registrarAddress"`
+			ContactNumber string `json:"registrarContactNumber"`
+		} `json:"shareRegistrar"`
+		InstrumentType struct {
+			ID          int32          `json:"id"`
+			Code        string         `json:"code"`
+			Description InstrumentType `json:"description"`
+		} `json:"instrumentType"`
+		ShareGroup struct {
+			ID          int32      `json:"id"`
+			Name        ShareGroup `json:"name"`
+			Description string     `json:"description"`
+		} `json:"shareGroupId"`
+		Company struct {
+			ID                 int32  `json:"id"`
+			ShortName          string `json:"companyShortName"`
+			Name               string `json:"companyName"`
+			Email              string `json:"email"`
+			Website            string `json:"companyWebsite"`
+			ContactPerson      string `json:"companyContactPerson"`
+			ContactNumber      string `json:"companyContactNumber"`
+			Address            string `json:"companyAddress"`
+			RegistrationNumber string `json:"companyRegistrationNumber"`
+			Sector             struct {
+				ID             int32  `json:"id"`
+				Description    string `json:"sectorDescription"`
+				RegulatoryBody string `json:"regulatoryBody"`
+			} `json:"sectorMaster"`
+		} `json:"companyId"`
 	} `json:"securityData"`
+	SecurityDailyTradeDto DailyTradeStats `json:"securityDailyTradeDto"`
+
+	// Capital structure
+	StockListedShares    int64   `json:"stockListedShares"`
+	PaidUpCapital        float64 `json:"paidUpCapital"`
+	IssuedCapital        float64 `json:"issuedCapital"`
+	MarketCapitalization float64 `json:"marketCapitalization"`
+	PublicShares         int64   `json:"publicShares"`
+	PublicPercentage     float64 `json:"publicPercentage"`
+	PromoterShares       int64   `json:"promoterShares"`
+	PromoterPercentage   float64 `json:"promoterPercentage"`
+	UpdatedDate          string  `json:"updatedDate"`
 }
 
 // CompanyDetails represents processed company information
@@ -269,37 +607,159 @@ type CompanyDetails struct {
 	PermittedToTrade string `json:"permittedToTrade"`
 
 	// Market data fields
-	OpenPrice           float64 `json:"openPrice"`
-	HighPrice           float64 `json:"highPrice"`
-	LowPrice            float64 `json:"lowPrice"`
-	ClosePrice          float64 `json:"closePrice"`
-	LastTradedPrice     float64 `json:"lastTradedPrice"`
-	PreviousClose       float64 `json:"previousClose"`
-	TotalTradeQuantity  int64   `json:"totalTradeQuantity"`
-	TotalTrades         int32   `json:"totalTrades"`
-	FiftyTwoWeekHigh    float64 `json:"fiftyTwoWeekHigh"`
-	FiftyTwoWeekLow     float64 `json:"fiftyTwoWeekLow"`
-	BusinessDate        string  `json:"businessDate"`
-	LastUpdatedDateTime string  `json:"lastUpdatedDateTime"`
+	OpenPrice           float64   `json:"openPrice"`
+	HighPrice           float64   `json:"highPrice"`
+	LowPrice            float64   `json:"lowPrice"`
+	ClosePrice          float64   `json:"closePrice"`
+	LastTradedPrice     float64   `json:"lastTradedPrice"`
+	PreviousClose       float64   `json:"previousClose"`
+	TotalTradeQuantity  int64     `json:"totalTradeQuantity"`
+	TotalTrades         int32     `json:"totalTrades"`
+	FiftyTwoWeekHigh    float64   `json:"fiftyTwoWeekHigh"`
+	FiftyTwoWeekLow     float64   `json:"fiftyTwoWeekLow"`
+	BusinessDate        Timestamp `json:"businessDate"`
+	LastUpdatedDateTime Timestamp `json:"lastUpdatedDateTime"`
+
+	// Share counts
+	ListedShares       int64   `json:"listedShares"`
+	PublicShares       int64   `json:"publicShares"`
+	PromoterShares     int64   `json:"promoterShares"`
+	PublicPercentage   float64 `json:"publicPercentage"`
+	PromoterPercentage float64 `json:"promoterPercentage"`
+	PaidUpCapital      float64 `json:"paidUpCapital"`
+
+	// Capital structure
+	IssuedCapital        float64 `json:"issuedCapital"`
+	MarketCapitalization float64 `json:"marketCapitalization"` // as reported by NEPSE
+	CapitalUpdatedDate   string  `json:"capitalUpdatedDate"`
+
+	// Instrument metadata
+	ISIN           string         `json:"isin"`
+	InstrumentType InstrumentType `json:"instrumentType"`
+	ShareGroup     ShareGroup     `json:"shareGroup"`
+	FaceValue      float64        `json:"faceValue"`
+	TickSize       float64        `json:"tickSize"`
+	ListingDate    string         `json:"listingDate"`
+
+	// Latest declared dividend, in percent of face value, when NEPSE reports it
+	CashDividend Null[float64] `json:"cashDividend"`
+	BonusShare   Null[float64] `json:"bonusShare"`
+
+	// Intraday trade statistics
+	DailyTrade DailyTradeStats `json:"dailyTrade"`
+}
+
+// DailyTradeStats holds a security's intraday trade statistics
+// (securityDailyTradeDto in the company details response)
+type DailyTradeStats struct {
+	SecurityID          string    `json:"securityId"`
+	OpenPrice           float64   `json:"openPrice"`
+	HighPrice           float64   `json:"highPrice"`
+	LowPrice            float64   `json:"lowPrice"`
+	ClosePrice          float64   `json:"closePrice"`
+	PreviousClose       float64   `json:"previousClose"`
+	LastTradedPrice     float64   `json:"lastTradedPrice"`
+	LastTradedVolume    int64     `json:"lastTradedVolume"`
+	AverageTradedPrice  float64   `json:"averageTradedPrice"`
+	TotalTradeQuantity  int64     `json:"totalTradeQuantity"`
+	TotalTrades         int32     `json:"totalTrades"`
+	BusinessDate        Timestamp `json:"businessDate"`
+	LastUpdatedDateTime Timestamp `json:"lastUpdatedDateTime"`
+}
+
+// MarketCap returns the market capitalization as close price times listed shares.
+// The last traded price is used while the session has no close price yet, and the
+// reported MarketCapitalization when the share count is unknown.
+func (c *CompanyDetails) MarketCap() float64 {
+	price := c.ClosePrice
+	if price == 0 {
+		price = c.LastTradedPrice
+	}
+	if c.ListedShares == 0 {
+		return c.MarketCapitalization
+	}
+	return price * float64(c.ListedShares)
+}
+
+// CompanyProfile holds company identity, governance contacts and instrument
+// metadata from the security detail response
+type CompanyProfile struct {
+	SecurityID         int32  `json:"securityId"`
+	Symbol             string `json:"symbol"`
+	SecurityName       string `json:"securityName"`
+	CompanyName        string `json:"companyName"`
+	CompanyShortName   string `json:"companyShortName"`
+	RegistrationNumber string `json:"registrationNumber"`
+	SectorName         string `json:"sectorName"`
+	RegulatoryBody     string `json:"regulatoryBody"`
+
+	// Contact
+	Website       string `json:"website"`
+	Email         string `json:"email"`
+	ContactPerson string `json:"contactPerson"`
+	ContactNumber string `json:"contactNumber"`
+	Address       string `json:"address"`
+
+	// Share registrar (RTA)
+	Registrar              string `json:"registrar"`
+	RegistrarAddress       string `json:"registrarAddress"`
+	RegistrarContactNumber string `json:"registrarContactNumber"`
+
+	// Instrument metadata
+	ISIN             string         `json:"isin"`
+	InstrumentCode   string         `json:"instrumentCode"`
+	InstrumentType   InstrumentType `json:"instrumentType"`
+	ShareGroup       ShareGroup     `json:"shareGroup"`
+	FaceValue        float64        `json:"faceValue"`
+	TickSize         float64        `json:"tickSize"`
+	CreditRating     string         `json:"creditRating"`
+	ListingDate      string         `json:"listingDate"`
+	TradingStartDate string         `json:"tradingStartDate"`
+	ActiveStatus     string         `json:"activeStatus"`
+	PermittedToTrade string         `json:"permittedToTrade"`
 }
 
 // LiveMarketEntry represents live market data entry
 type LiveMarketEntry struct {
-	Symbol           string  `json:"symbol"`
-	SecurityName     string  `json:"securityName"`
-	OpenPrice        float64 `json:"openPrice"`
-	HighPrice        float64 `json:"highPrice"`
-	LowPrice         float64 `json:"lowPrice"`
-	ClosePrice       float64 `json:"closePrice"`
-	PercentChange    float64 `json:"percentChange"`
-	Volume           int64   `json:"volume"`
-	PreviousClose    float64 `json:"previousClose"`
-	LastTradedVolume int64   `json:"lastTradedVolume"`
+	Symbol           string        `json:"symbol"`
+	SecurityName     string        `json:"securityName"`
+	OpenPrice        float64       `json:"openPrice"`
+	HighPrice        float64       `json:"highPrice"`
+	LowPrice         float64       `json:"lowPrice"`
+	ClosePrice       float64       `json:"closePrice"`
+	PercentChange    Null[float64] `json:"percentChange"`
+	Volume           int64         `json:"volume"`
+	PreviousClose    float64       `json:"previousClose"`
+	LastTradedVolume int64         `json:"lastTradedVolume"`
 }
 
 // SectorScrips represents scrips grouped by sector
 type SectorScrips map[string][]string
 
+// SectorScripsOptions controls how GetSectorScripsWithOptions groups securities
+type SectorScripsOptions struct {
+	// IncludeSuspended also groups suspended securities
+	IncludeSuspended bool
+
+	// ResolveFromCompanyList looks up the sectors the security list leaves out in
+	// the company list, one extra request. Securities it cannot resolve stay
+	// unresolved, also when the request fails.
+	ResolveFromCompanyList bool
+
+	// ResolveFromDetails fetches company details for securities still without a
+	// sector, one request each
+	ResolveFromDetails bool
+}
+
+// SectorSummary aggregates a session's trading activity for one sector
+type SectorSummary struct {
+	Sector       string  `json:"sector"`
+	Turnover     float64 `json:"turnover"`
+	TradedShares int64   `json:"tradedShares"`
+	Transactions int64   `json:"transactions"`
+	TradedScrips int     `json:"tradedScrips"`
+}
+
 // PaginatedResponse represents a generic paginated response
 type PaginatedResponse[T any] struct {
 	Content          []T   `json:"content"`
@@ -312,3 +772,217 @@ type PaginatedResponse[T any] struct {
 	NumberOfElements int32 `json:"numberOfElements"`
 }
 
+// Broker represents a NEPSE member (brokerage firm)
+type Broker struct {
+	ID            int32  `json:"id"`
+	MemberCode    string `json:"memberCode"`
+	MemberName    string `json:"memberName"`
+	Address       string `json:"memberAddress"`
+	ContactPerson string `json:"contactPerson"`
+	ContactNumber string `json:"contactNumber"`
+	Email         string `json:"emailAddress"`
+	WebsiteURL    string `json:"websiteUrl"`
+	TMSLink       string `json:"tmsLink"`
+	ActiveStatus  string `json:"activeStatus"`
+}
+
+// NewsItem represents an exchange news item, alert or circular
+type NewsItem struct {
+	ID            int32  `json:"id"`
+	Title         string `json:"messageTitle"`
+	Body          string `json:"messageBody"`
+	FilePath      string `json:"filePath"`
+	AttachmentURL string `json:"attachmentUrl,omitempty"` // resolved from FilePath by the client
+	PublishedDate string `json:"addedDate"`
+	ExpiryDate    string `json:"expiryDate"`
+	Remarks       string `json:"remarks"`
+}
+
+// Publication represents an exchange document such as a press release,
+// circular or application report
+type Publication struct {
+	ID            int32  `json:"id"`
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+	Category      string `json:"category"`
+	FilePath      string `json:"filePath"`
+	DocumentURL   string `json:"documentUrl,omitempty"` // resolved from FilePath by the client
+	PublishedDate string `json:"publishedDate"`
+}
+
+// CompanyDisclosure represents an announcement filed by a listed company
+// (financial reports, AGM notices, right share announcements, etc.)
+type CompanyDisclosure struct {
+	ID            int32  `json:"id"`
+	SecurityID    int32  `json:"securityId"`
+	Symbol        string `json:"symbol"`
+	Title         string `json:"newsHeadline"`
+	Body          string `json:"newsBody"`
+	Category      string `json:"newsType"`
+	FiscalYear    string `json:"fiscalYear"`
+	FilePath      string `json:"filePath"`
+	DocumentURL   string `json:"documentUrl,omitempty"` // resolved from FilePath by the client
+	PublishedDate string `json:"addedDate"`
+}
+
+// Dividend represents the dividend declared by a company for a fiscal year
+type Dividend struct {
+	FiscalYear    string        `json:"fiscalYear"`
+	BonusPercent  Null[float64] `json:"bonusShare"`
+	CashPercent   Null[float64] `json:"cashDividend"`
+	RightShare    string        `json:"rightShare"`
+	BookCloseDate string        `json:"bookCloseDate"`
+	AnnouncedDate string        `json:"modifiedDate"`
+}
+
+// TotalPercent returns the combined bonus and cash dividend percentage, counting
+// an unreported part as zero
+func (d Dividend) TotalPercent() float64 {
+	return d.BonusPercent.Or(0) + d.CashPercent.Or(0)
+}
+
+// CorporateEventType is the kind of a corporate event
+type CorporateEventType string
+
+const (
+	CorporateEventAGM         CorporateEventType = "AGM"
+	CorporateEventSGM         CorporateEventType = "SGM"
+	CorporateEventBookClosure CorporateEventType = "BOOK_CLOSURE"
+)
+
+// CorporateEvent represents an AGM/SGM and its book-closure (record) date
+type CorporateEvent struct {
+	ID            int32              `json:"id"`
+	SecurityID    int32              `json:"securityId"`
+	Symbol        string             `json:"symbol"`
+	EventType     CorporateEventType `json:"eventType"`
+	FiscalYear    string             `json:"fiscalYear"`
+	EventDate     string             `json:"agmDate"`
+	BookCloseDate string             `json:"bookCloseDate"`
+	Venue         string             `json:"venue"`
+	Agenda        string             `json:"agenda"`
+}
+
+// IssueType is the kind of a public issue
+type IssueType string
+
+const (
+	IssueTypeIPO        IssueType = "IPO"
+	IssueTypeFPO        IssueType = "FPO"
+	IssueTypeRight      IssueType = "RIGHT"
+	IssueTypeDebenture  IssueType = "DEBENTURE"
+	IssueTypeMutualFund IssueType = "MUTUAL_FUND"
+)
+
+// Issue represents an IPO, FPO, right share or debenture issue in the NEPSE pipeline
+type Issue struct {
+	ID           int32     `json:"id"`
+	Symbol       string    `json:"symbol"`
+	CompanyName  string    `json:"companyName"`
+	IssueType    IssueType `json:"issueType"`
+	IssueManager string    `json:"issueManager"`
+	Units        int64     `json:"units"`
+	Price        float64   `json:"price"`
+	OpenDate     string    `json:"openDate"`
+	CloseDate    string    `json:"closeDate"`
+	Status       string    `json:"status"`
+}
+
+// Debenture represents a listed debenture or bond
+type Debenture struct {
+	SecurityID      int32   `json:"securityId"`
+	Symbol          string  `json:"symbol"`
+	SecurityName    string  `json:"securityName"`
+	CouponRate      float64 `json:"couponRate"`
+	InterestPayment string  `json:"interestPaymentFrequency"`
+	FaceValue       float64 `json:"faceValue"`
+	IssueSize       int64   `json:"issueSize"`
+	IssueDate       string  `json:"issueDate"`
+	MaturityDate    string  `json:"maturityDate"`
+	ListingDate     string  `json:"listingDate"`
+}
+
+// IndexConstituent represents a security that makes up an index, with its weight
+type IndexConstituent struct {
+	SecurityID           int32   `json:"securityId"`
+	Symbol               string  `json:"symbol"`
+	SecurityName         string  `json:"securityName"`
+	Weight               float64 `json:"weightage"` // percent of the index
+	ClosePrice           float64 `json:"closePrice"`
+	MarketCapitalization float64 `json:"marketCapitalization"`
+}
+
+// Sector represents a NEPSE sector from the sector master
+type Sector struct {
+	ID             int32  `json:"id"`
+	Name           string `json:"sectorDescription"`
+	RegulatoryBody string `json:"regulatoryBody"`
+	ActiveStatus   string `json:"activeStatus"`
+}
+
+// FiftyTwoWeekEntry represents a security trading at or near a 52-week extreme
+type FiftyTwoWeekEntry struct {
+	SecurityID       int32   `json:"securityId"`
+	Symbol           string  `json:"symbol"`
+	SecurityName     string  `json:"securityName"`
+	LastTradedPrice  float64 `json:"lastTradedPrice"`
+	FiftyTwoWeekHigh float64 `json:"fiftyTwoWeekHigh"`
+	FiftyTwoWeekLow  float64 `json:"fiftyTwoWeekLow"`
+}
+
+// FiftyTwoWeekHighLow is the exchange-published 52-week high/low list
+type FiftyTwoWeekHighLow struct {
+	High []FiftyTwoWeekEntry `json:"high"`
+	Low  []FiftyTwoWeekEntry `json:"low"`
+}
+
+// MarketCapEntry represents a security in the market-capitalization ranking
+type MarketCapEntry struct {
+	SecurityID                int32   `json:"securityId"`
+	Symbol                    string  `json:"symbol"`
+	SecurityName              string  `json:"securityName"`
+	ClosePrice                float64 `json:"closePrice"`
+	MarketCapitalization      float64 `json:"marketCapitalization"`
+	FloatMarketCapitalization float64 `json:"floatMarketCapitalization"`
+}
+
+// IndexHistoryEntry represents the daily values of an index
+type IndexHistoryEntry struct {
+	BusinessDate     Timestamp `json:"businessDate"`
+	OpenIndex        float64   `json:"openIndex"`
+	HighIndex        float64   `json:"highIndex"`
+	LowIndex         float64   `json:"lowIndex"`
+	CloseIndex       float64   `json:"closingIndex"`
+	Change           float64   `json:"absChange"`
+	PercentChange    float64   `json:"percentageChange"`
+	TurnoverValue    float64   `json:"turnoverValue"`
+	TurnoverVolume   float64   `json:"turnoverVolume"`
+	TotalTransaction int64     `json:"totalTransaction"`
+}
+
+// MarketSummaryHistoryEntry represents the market-wide totals of one business day
+type MarketSummaryHistoryEntry struct {
+	BusinessDate              Timestamp `json:"businessDate"`
+	TotalTurnover             float64   `json:"totalTurnover"`
+	TotalTradedShares         int64     `json:"totalTradedShares"`
+	TotalTransactions         int64     `json:"totalTransactions"`
+	TradedScrips              int32     `json:"tradedScrips"`
+	TotalMarketCapitalization float64   `json:"totalMarketCapitalization"`
+	FloatMarketCapitalization float64   `json:"floatMarketCapitalization"`
+}
+
+// PriceBand is the daily allowed price range (circuit limits) of a security
+type PriceBand struct {
+	SecurityID    int32   `json:"securityId"`
+	Symbol        string  `json:"symbol"`
+	PreviousClose float64 `json:"previousClose"`
+	Percent       float64 `json:"percent"`
+	TickSize      float64 `json:"tickSize"`
+	Upper         float64 `json:"upper"`
+	Lower         float64 `json:"lower"`
+}
+
+// Contains reports whether price lies within the band
+func (b *PriceBand) Contains(price float64) bool {
+	return price >= b.Lower && price <= b.Upper
+}
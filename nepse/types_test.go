@@ -0,0 +1,127 @@
+package nepse
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// decodeFixture decodes a recorded NEPSE payload from testdata into v
+func decodeFixture(t *testing.T, name string, v any) {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		t.Fatalf("decode %s: %v", name, err)
+	}
+}
+
+// field is a decoded value and the value the fixture should produce
+type field struct {
+	name      string
+	got, want any
+}
+
+func checkFields(t *testing.T, fields []field) {
+	t.Helper()
+	for _, f := range fields {
+		if f.got != f.want {
+			t.Errorf("%s = %v, want %v", f.name, f.got, f.want)
+		}
+	}
+}
+
+func TestTodayPriceFixture(t *testing.T) {
+	var prices []TodayPrice
+	decodeFixture(t, "today_price.json", &prices)
+	if len(prices) != 2 {
+		t.Fatalf("decoded %d rows, want 2", len(prices))
+	}
+
+	// NEPSE's own row: no previousClose, lastTradedPrice or change columns
+	nabil := prices[0]
+	checkFields(t, []field{
+		{"AverageTradedPrice", nabil.AverageTradedPrice, 522.63},
+		{"MarketCapitalization", nabil.MarketCapitalization, 142196543210.5},
+		{"FiftyTwoWeekHigh", nabil.FiftyTwoWeekHigh, 720.0},
+		{"FiftyTwoWeekLow", nabil.FiftyTwoWeekLow, 496.1},
+		{"LastUpdatedPrice", nabil.LastUpdatedPrice, 525.5},
+		{"LastUpdatedTime", nabil.LastUpdatedTime.Raw, "2024-05-12T14:59:57.123"},
+		{"PreviousDayClosePrice", nabil.PreviousDayClosePrice, 518.0},
+		{"PreviousClose", nabil.PreviousClose, 518.0},
+		{"LastTradedPrice", nabil.LastTradedPrice, 525.5},
+		{"DifferenceRs", nabil.DifferenceRs, 7.5},
+		{"PercentageChange", nabil.PercentageChange, NullOf(1.45)},
+	})
+	want := time.Date(2024, 5, 12, 14, 59, 57, 123e6, NepalLocation)
+	if !nabil.LastUpdatedTime.Equal(want) {
+		t.Errorf("LastUpdatedTime = %v, want %v", nabil.LastUpdatedTime.Time, want)
+	}
+
+	// Published columns win over the fallbacks
+	nica := prices[1]
+	checkFields(t, []field{
+		{"PreviousClose", nica.PreviousClose, 408.0},
+		{"LastTradedPrice", nica.LastTradedPrice, 404.9},
+		{"DifferenceRs", nica.DifferenceRs, -3.0},
+		{"PercentageChange", nica.PercentageChange, NullOf(-0.74)},
+	})
+}
+
+func TestTopListFixtures(t *testing.T) {
+	tests := []struct {
+		fixture string
+		fields  func(e *TopListEntry) []field
+	}{
+		{"top_gainers.json", func(e *TopListEntry) []field {
+			return []field{
+				{"SecurityID", e.SecurityID, int32(2905)},
+				{"LTP", e.LTP, 1126.4},
+				{"PointChange", e.PointChange, 102.4},
+				{"ClosePrice", e.ClosePrice, 1126.4},
+				{"DifferenceRs", e.DifferenceRs, 102.4},
+				{"PreviousClose", e.PreviousClose, 1024.0},
+				{"PercentageChange", e.PercentageChange, NullOf(10.0)},
+			}
+		}},
+		{"top_turnover.json", func(e *TopListEntry) []field {
+			return []field{
+				{"SecurityID", e.SecurityID, int32(131)},
+				{"Turnover", e.Turnover, 44012345.6},
+				{"ClosingPrice", e.ClosingPrice, 525.5},
+				{"TotalTradedValue", e.TotalTradedValue, 44012345.6},
+				{"ClosePrice", e.ClosePrice, 525.5},
+			}
+		}},
+		{"top_trade.json", func(e *TopListEntry) []field {
+			return []field{
+				{"SecurityID", e.SecurityID, int32(2792)},
+				{"ShareTraded", e.ShareTraded, int64(30110)},
+				{"TotalTradedQuantity", e.TotalTradedQuantity, int64(30110)},
+				{"ClosePrice", e.ClosePrice, 405.0},
+			}
+		}},
+		{"top_transaction.json", func(e *TopListEntry) []field {
+			return []field{
+				{"SecurityID", e.SecurityID, int32(2811)},
+				{"LastTradedPrice", e.LastTradedPrice, 233.5},
+				{"TotalTrades", e.TotalTrades, int32(2841)},
+				{"ClosePrice", e.ClosePrice, 233.5},
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			var entries []TopListEntry
+			decodeFixture(t, tt.fixture, &entries)
+			if len(entries) != 1 {
+				t.Fatalf("decoded %d entries, want 1", len(entries))
+			}
+			checkFields(t, tt.fields(&entries[0]))
+		})
+	}
+}
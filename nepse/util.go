@@ -1,6 +1,11 @@
 package nepse
 
-import "time"
+import (
+    "math"
+    "net/url"
+    "strings"
+    "time"
+)
 
 func minInt(a, b int) int {
     if a < b {
@@ -16,3 +21,22 @@ func minDuration(a, b time.Duration) time.Duration {
     return b
 }
 
+
+// withQuery appends encoded query parameters to an endpoint path,
+// respecting any query string already present in the path
+func withQuery(endpoint string, params url.Values) string {
+    if len(params) == 0 {
+        return endpoint
+    }
+    sep := "?"
+    if strings.Contains(endpoint, "?") {
+        sep = "&"
+    }
+    return endpoint + sep + params.Encode()
+}
+
+// roundTo rounds v to the given number of decimal places
+func roundTo(v float64, places int) float64 {
+	scale := math.Pow(10, float64(places))
+	return math.Round(v*scale) / scale
+}
@@ -0,0 +1,56 @@
+package nepse
+
+import (
+	"fmt"
+	"time"
+)
+
+// Arguments are validated before a request is sent, so a malformed date or ID
+// fails locally with an invalid_argument error naming the parameter instead of
+// an opaque 400 or 500 from NEPSE.
+
+// validateID checks that an ID argument such as a security ID is positive
+func validateID(name string, id int32) error {
+	if id <= 0 {
+		return NewInvalidArgumentError(fmt.Sprintf("%s must be positive, got %d", name, id))
+	}
+	return nil
+}
+
+// parseDateArg parses an AD date argument (YYYY-MM-DD)
+func parseDateArg(param, date string) (time.Time, error) {
+	t, err := time.ParseInLocation(DateFormat, date, NepalLocation)
+	if err != nil {
+		return time.Time{}, NewInvalidArgumentError(fmt.Sprintf("invalid %s %q, expected YYYY-MM-DD", param, date))
+	}
+	return t, nil
+}
+
+// validateDateRange checks that neither AD date of a range is malformed and that
+// the range does not end before it starts. Either end may be empty.
+func validateDateRange(startDate, endDate string) error {
+	var start, end time.Time
+	var err error
+	if startDate != "" {
+		if start, err = parseDateArg("startDate", startDate); err != nil {
+			return err
+		}
+	}
+	if endDate != "" {
+		if end, err = parseDateArg("endDate", endDate); err != nil {
+			return err
+		}
+	}
+	if startDate != "" && endDate != "" && end.Before(start) {
+		return NewInvalidArgumentError(fmt.Sprintf("endDate %s is before startDate %s", endDate, startDate))
+	}
+	return nil
+}
+
+// validateInterval checks that a polling interval is positive
+func validateInterval(interval time.Duration) error {
+	if interval <= 0 {
+		return NewInvalidArgumentError(fmt.Sprintf("interval must be positive, got %s", interval))
+	}
+	return nil
+}
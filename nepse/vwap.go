@@ -0,0 +1,121 @@
+package nepse
+
+import "sync"
+
+// VWAPCalculator computes the session volume-weighted average price of each
+// symbol it is fed, from floor sheet trades or polled quotes. Feed a symbol from
+// one of the two only, or its trades are counted twice. Safe for concurrent use.
+type VWAPCalculator struct {
+	mu      sync.Mutex
+	symbols map[string]*vwapState
+}
+
+type vwapState struct {
+	value  float64 // sum of price times quantity
+	volume int64
+	// quoteVolume is the session volume of the last quote, to take the
+	// difference of the next one
+	quoteVolume int64
+	quoted      bool
+	date        string
+}
+
+// NewVWAPCalculator returns an empty calculator
+func NewVWAPCalculator() *VWAPCalculator {
+	return &VWAPCalculator{symbols: make(map[string]*vwapState)}
+}
+
+// AddTrade records a trade of quantity shares at price
+func (c *VWAPCalculator) AddTrade(symbol string, price float64, quantity int64) {
+	if quantity <= 0 || price <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	state := c.state(NormalizeSymbol(symbol))
+	state.value += price * float64(quantity)
+	state.volume += quantity
+}
+
+// AddFloorSheet records floor sheet trades, e.g. the batches of TailFloorSheet.
+// A trade from a later business date than a symbol's previous trades starts a
+// new session for it.
+func (c *VWAPCalculator) AddFloorSheet(trades []FloorSheetEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := range trades {
+		t := &trades[i]
+		if t.ContractQuantity <= 0 || t.ContractRate <= 0 {
+			continue
+		}
+		state := c.state(NormalizeSymbol(t.StockSymbol))
+		if !t.BusinessDate.IsZero() {
+			date := t.BusinessDate.Format(DateFormat)
+			if date < state.date {
+				continue
+			}
+			if date > state.date {
+				*state = vwapState{date: date}
+			}
+		}
+		state.value += t.ContractRate * float64(t.ContractQuantity)
+		state.volume += t.ContractQuantity
+	}
+}
+
+// AddQuote records the trades since the symbol's previous quote, taken as the
+// volume increase at the last traded price. The first quote of a session seeds
+// the VWAP from its turnover when the quote has one (today's prices do); live
+// market quotes don't, so their VWAP covers trades from the first quote on. A
+// volume drop is taken as a new session.
+func (c *VWAPCalculator) AddQuote(q Quote) {
+	if q.LastTradedPrice <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	state := c.state(NormalizeSymbol(q.Symbol))
+	if q.Volume < state.quoteVolume {
+		*state = vwapState{}
+	}
+	switch {
+	case state.quoted:
+		if traded := q.Volume - state.quoteVolume; traded > 0 {
+			state.value += q.LastTradedPrice * float64(traded)
+			state.volume += traded
+		}
+	case q.Turnover > 0 && q.Volume > 0:
+		state.value = q.Turnover
+		state.volume = q.Volume
+	}
+	state.quoteVolume, state.quoted = q.Volume, true
+}
+
+// VWAP returns the VWAP of a symbol and the volume it covers; ok is false if no
+// trade of the symbol was recorded
+func (c *VWAPCalculator) VWAP(symbol string) (vwap float64, volume int64, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	state, found := c.symbols[NormalizeSymbol(symbol)]
+	if !found || state.volume == 0 {
+		return 0, 0, false
+	}
+	return roundTo(state.value/float64(state.volume), 2), state.volume, true
+}
+
+// Reset forgets every symbol, e.g. before a new session
+func (c *VWAPCalculator) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.symbols)
+}
+
+// state returns the state of a normalized symbol, creating it if needed
+func (c *VWAPCalculator) state(symbol string) *vwapState {
+	state, ok := c.symbols[symbol]
+	if !ok {
+		state = &vwapState{}
+		c.symbols[symbol] = state
+	}
+	return state
+}
@@ -0,0 +1,171 @@
+package nepse
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Watchlist follows the quotes of a fixed set of securities. Symbols are
+// resolved once when it is created, and each refresh fetches every quote in one
+// batch: the live market, plus today's prices for securities not trading live.
+// Read the latest quotes with Snapshot, or receive changes from Watch.
+type Watchlist struct {
+	client  Client
+	symbols []string
+	ids     map[string]int32
+	vwap    *VWAPCalculator
+
+	mu      sync.RWMutex
+	quotes  map[string]Quote
+	updated time.Time
+}
+
+// WatchlistUpdate is the result of one watchlist refresh: the quotes that
+// changed, keyed by symbol, or the error the refresh failed with
+type WatchlistUpdate struct {
+	Changed map[string]Quote
+	Time    time.Time
+	Err     error
+}
+
+// NewWatchlist resolves symbols and returns a watchlist of them. Unknown
+// symbols fail with SymbolErrors, as from ResolveSymbols.
+func NewWatchlist(ctx context.Context, client Client, symbols ...string) (*Watchlist, error) {
+	if len(symbols) == 0 {
+		return nil, NewInvalidArgumentError("watchlist needs at least one symbol")
+	}
+	resolved, err := client.ResolveSymbols(ctx, symbols)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Watchlist{
+		client: client,
+		ids:    make(map[string]int32, len(resolved)),
+		quotes: make(map[string]Quote, len(resolved)),
+		vwap:   NewVWAPCalculator(),
+	}
+	for symbol, id := range resolved {
+		w.ids[NormalizeSymbol(symbol)] = id
+	}
+	w.symbols = slices.Sorted(maps.Keys(w.ids))
+	return w, nil
+}
+
+// Symbols returns the normalized symbols of the watchlist, sorted
+func (w *Watchlist) Symbols() []string {
+	return slices.Clone(w.symbols)
+}
+
+// Snapshot returns the latest quote of each symbol, keyed by symbol, and when
+// they were refreshed. Symbols with no quote yet are absent.
+func (w *Watchlist) Snapshot() (map[string]Quote, time.Time) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return maps.Clone(w.quotes), w.updated
+}
+
+// VWAP returns the session VWAP of a watched symbol, computed from the volume
+// traded between refreshes. Trades before the first refresh are included only
+// for securities quoted from today's prices, which carry the session turnover.
+// ok is false if the symbol isn't watched or hasn't traded yet.
+func (w *Watchlist) VWAP(symbol string) (vwap float64, ok bool) {
+	vwap, _, ok = w.vwap.VWAP(symbol)
+	return vwap, ok
+}
+
+// Refresh fetches the current quotes and returns those that changed since the
+// last refresh
+func (w *Watchlist) Refresh(ctx context.Context) (map[string]Quote, error) {
+	quotes, err := w.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return w.apply(quotes), nil
+}
+
+// apply stores fetched quotes and returns those that changed
+func (w *Watchlist) apply(quotes map[string]Quote) map[string]Quote {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	changed := make(map[string]Quote)
+	for symbol, quote := range quotes {
+		w.vwap.AddQuote(quote)
+		if last, ok := w.quotes[symbol]; ok && sameTick(last, quote) {
+			continue
+		}
+		w.quotes[symbol] = quote
+		changed[symbol] = quote
+	}
+	w.updated = time.Now()
+	return changed
+}
+
+// Watch refreshes the watchlist every interval until ctx is done and sends an
+// update for each refresh that changed a quote or failed. The first refresh
+// sends every quote. Slow receivers are handled by the backpressure policy of
+// the watchlist's client, and updates arrive through its stream Transport.
+func (w *Watchlist) Watch(ctx context.Context, interval time.Duration) (<-chan WatchlistUpdate, error) {
+	if err := validateInterval(interval); err != nil {
+		return nil, err
+	}
+
+	updates := newOutbox(w.client, mergeWatchlistUpdates)
+	go func() {
+		defer updates.close()
+		feed := Feed{Kind: FeedWatchlist, Key: strings.Join(w.symbols, ","), Interval: interval}
+		streamFeed(ctx, transportOf(w.client), feed, w.fetch, func(ctx context.Context, quotes map[string]Quote, err error) error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			var changed map[string]Quote
+			if err == nil {
+				changed = w.apply(quotes)
+			}
+			if err == nil && len(changed) == 0 {
+				return nil
+			}
+			updates.send(ctx, WatchlistUpdate{Changed: changed, Time: time.Now(), Err: err})
+			return err
+		})
+	}()
+	return updates.ch, nil
+}
+
+// fetch returns the current quote of every watched security that has one
+func (w *Watchlist) fetch(ctx context.Context) (map[string]Quote, error) {
+	live, err := w.client.GetLiveMarketFor(ctx, w.symbols)
+	if err != nil {
+		return nil, err
+	}
+	quotes := make(map[string]Quote, len(w.symbols))
+	now := Timestamp{Time: time.Now().In(NepalLocation)}
+	for i := range live {
+		quote := live[i].Quote()
+		quote.SecurityID = w.ids[quote.Symbol]
+		quote.AsOf = now
+		quotes[quote.Symbol] = quote
+	}
+	if len(quotes) == len(w.symbols) {
+		return quotes, nil
+	}
+
+	prices, err := w.client.GetTodaysPrices(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	for i := range prices {
+		symbol := prices[i].Symbol
+		if _, watched := w.ids[symbol]; !watched {
+			continue
+		}
+		if _, ok := quotes[symbol]; !ok {
+			quotes[symbol] = prices[i].Quote()
+		}
+	}
+	return quotes, nil
+}
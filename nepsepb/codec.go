@@ -0,0 +1,102 @@
+// Package nepsepb carries the public NEPSE models as the protobuf messages of
+// nepse.proto, so services can ship them over gRPC, Kafka or any other
+// transport with a stable, versioned schema.
+//
+// The message types are generated from nepse.proto; converters such as
+// TodayPriceToProto and TodayPriceFromProto map them to and from the models,
+// and Marshal and Unmarshal encode models directly:
+//
+//	data := nepsepb.Marshal(&price)
+//	var decoded nepse.TodayPrice
+//	err = nepsepb.Unmarshal(data, &decoded)
+package nepsepb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative nepse.proto
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/voidarchive/nepseauth/nepse"
+)
+
+// Message is the set of models with a schema in nepse.proto
+type Message interface {
+	nepse.Quote | nepse.MarketSummary | nepse.Security | nepse.TodayPrice | nepse.PriceHistory |
+		nepse.FloorSheetEntry | nepse.TopListEntry | nepse.LiveMarketEntry | nepse.MarketDepth |
+		nepse.NepseIndex | nepse.MarketStatus | nepse.PriceBand
+}
+
+// Marshal encodes a model as its nepse.proto message. Invalid UTF-8 in
+// strings is replaced, as proto3 strings must be valid UTF-8.
+func Marshal[T Message](m *T) []byte {
+	b, err := proto.Marshal(converterFor[T]().to(m))
+	if err != nil {
+		// Unreachable: converted models hold nothing proto.Marshal rejects
+		panic(fmt.Sprintf("nepsepb: failed to marshal %T: %v", m, err))
+	}
+	return b
+}
+
+// Unmarshal decodes a nepse.proto message into m, replacing its contents.
+// Unknown fields are skipped, for forward compatibility.
+func Unmarshal[T Message](b []byte, m *T) error {
+	c := converterFor[T]()
+	p := c.message()
+	if err := proto.Unmarshal(b, p); err != nil {
+		return fmt.Errorf("nepsepb: %w", err)
+	}
+	*m = c.from(p)
+	return nil
+}
+
+// converter maps a model type to and from its generated message
+type converter[T Message] struct {
+	message func() proto.Message
+	to      func(*T) proto.Message
+	from    func(proto.Message) T
+}
+
+func convert[T Message, P proto.Message](to func(*T) P, from func(P) T) converter[T] {
+	return converter[T]{
+		message: func() proto.Message {
+			var p P
+			return p.ProtoReflect().Type().New().Interface()
+		},
+		to:   func(m *T) proto.Message { return to(m) },
+		from: func(p proto.Message) T { return from(p.(P)) },
+	}
+}
+
+// converterFor returns the converter of a model type
+func converterFor[T Message]() converter[T] {
+	var c any
+	switch any((*T)(nil)).(type) {
+	case *nepse.Quote:
+		c = convert(QuoteToProto, QuoteFromProto)
+	case *nepse.MarketSummary:
+		c = convert(MarketSummaryToProto, MarketSummaryFromProto)
+	case *nepse.Security:
+		c = convert(SecurityToProto, SecurityFromProto)
+	case *nepse.TodayPrice:
+		c = convert(TodayPriceToProto, TodayPriceFromProto)
+	case *nepse.PriceHistory:
+		c = convert(PriceHistoryToProto, PriceHistoryFromProto)
+	case *nepse.FloorSheetEntry:
+		c = convert(FloorSheetEntryToProto, FloorSheetEntryFromProto)
+	case *nepse.TopListEntry:
+		c = convert(TopListEntryToProto, TopListEntryFromProto)
+	case *nepse.LiveMarketEntry:
+		c = convert(LiveMarketEntryToProto, LiveMarketEntryFromProto)
+	case *nepse.MarketDepth:
+		c = convert(MarketDepthToProto, MarketDepthFromProto)
+	case *nepse.NepseIndex:
+		c = convert(NepseIndexToProto, NepseIndexFromProto)
+	case *nepse.MarketStatus:
+		c = convert(MarketStatusToProto, MarketStatusFromProto)
+	case *nepse.PriceBand:
+		c = convert(PriceBandToProto, PriceBandFromProto)
+	}
+	return c.(converter[T])
+}
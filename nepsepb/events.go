@@ -0,0 +1,117 @@
+package nepsepb
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/voidarchive/nepseauth/events"
+)
+
+// EventToProto converts an event of the events package to a MarketEvent
+func EventToProto(e events.Event) (*MarketEvent, error) {
+	switch e := e.(type) {
+	case events.MarketOpened:
+		return &MarketEvent{Event: &MarketEvent_MarketOpened{MarketOpened: &MarketOpened{
+			Time:   timestampToProto(e.Time),
+			Status: MarketStatusToProto(&e.Status),
+		}}}, nil
+	case events.MarketClosed:
+		return &MarketEvent{Event: &MarketEvent_MarketClosed{MarketClosed: &MarketClosed{
+			Time:   timestampToProto(e.Time),
+			Status: MarketStatusToProto(&e.Status),
+		}}}, nil
+	case events.PriceTick:
+		return &MarketEvent{Event: &MarketEvent_PriceTick{PriceTick: &PriceTick{
+			Time:  timestampToProto(e.Time),
+			Quote: QuoteToProto(&e.Quote),
+		}}}, nil
+	case events.IndexTick:
+		return &MarketEvent{Event: &MarketEvent_IndexTick{IndexTick: &IndexTick{
+			Time:  timestampToProto(e.Time),
+			Name:  text(e.Name),
+			Index: NepseIndexToProto(&e.Index),
+		}}}, nil
+	case events.VWAPTick:
+		return &MarketEvent{Event: &MarketEvent_VwapTick{VwapTick: &VWAPTick{
+			Time:   timestampToProto(e.Time),
+			Symbol: text(e.Symbol),
+			Vwap:   e.VWAP,
+			Volume: e.Volume,
+		}}}, nil
+	case events.CircuitBreakerHit:
+		return &MarketEvent{Event: &MarketEvent_CircuitBreakerHit{CircuitBreakerHit: &CircuitBreakerHit{
+			Time:  timestampToProto(e.Time),
+			Quote: QuoteToProto(&e.Quote),
+			Band:  PriceBandToProto(&e.Band),
+			Upper: e.Upper,
+		}}}, nil
+	}
+	return nil, fmt.Errorf("nepsepb: no MarketEvent message for %T", e)
+}
+
+// EventFromProto converts a MarketEvent to the event it carries
+func EventFromProto(p *MarketEvent) (events.Event, error) {
+	switch e := p.GetEvent().(type) {
+	case *MarketEvent_MarketOpened:
+		return events.MarketOpened{
+			Time:   timeFromProto(e.MarketOpened.GetTime()),
+			Status: MarketStatusFromProto(e.MarketOpened.GetStatus()),
+		}, nil
+	case *MarketEvent_MarketClosed:
+		return events.MarketClosed{
+			Time:   timeFromProto(e.MarketClosed.GetTime()),
+			Status: MarketStatusFromProto(e.MarketClosed.GetStatus()),
+		}, nil
+	case *MarketEvent_PriceTick:
+		return events.PriceTick{
+			Time:  timeFromProto(e.PriceTick.GetTime()),
+			Quote: QuoteFromProto(e.PriceTick.GetQuote()),
+		}, nil
+	case *MarketEvent_IndexTick:
+		return events.IndexTick{
+			Time:  timeFromProto(e.IndexTick.GetTime()),
+			Name:  e.IndexTick.GetName(),
+			Index: NepseIndexFromProto(e.IndexTick.GetIndex()),
+		}, nil
+	case *MarketEvent_VwapTick:
+		return events.VWAPTick{
+			Time:   timeFromProto(e.VwapTick.GetTime()),
+			Symbol: e.VwapTick.GetSymbol(),
+			VWAP:   e.VwapTick.GetVwap(),
+			Volume: e.VwapTick.GetVolume(),
+		}, nil
+	case *MarketEvent_CircuitBreakerHit:
+		return events.CircuitBreakerHit{
+			Time:  timeFromProto(e.CircuitBreakerHit.GetTime()),
+			Quote: QuoteFromProto(e.CircuitBreakerHit.GetQuote()),
+			Band:  PriceBandFromProto(e.CircuitBreakerHit.GetBand()),
+			Upper: e.CircuitBreakerHit.GetUpper(),
+		}, nil
+	}
+	return nil, errors.New("nepsepb: MarketEvent carries no known event")
+}
+
+// MarshalEvent encodes an event of the events package as a MarketEvent message
+func MarshalEvent(e events.Event) ([]byte, error) {
+	p, err := EventToProto(e)
+	if err != nil {
+		return nil, err
+	}
+	b, err := proto.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("nepsepb: %w", err)
+	}
+	return b, nil
+}
+
+// UnmarshalEvent decodes a MarketEvent message. Of a oneof set more than once
+// the last value wins, as in protobuf.
+func UnmarshalEvent(b []byte) (events.Event, error) {
+	var p MarketEvent
+	if err := proto.Unmarshal(b, &p); err != nil {
+		return nil, fmt.Errorf("nepsepb: %w", err)
+	}
+	return EventFromProto(&p)
+}
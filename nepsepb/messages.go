@@ -0,0 +1,531 @@
+package nepsepb
+
+import (
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/voidarchive/nepseauth/nepse"
+)
+
+// The converters below map each model to its generated message and back. The
+// ...FromProto functions accept nil messages, which convert to zero models.
+
+// QuoteToProto converts a quote to its message
+func QuoteToProto(m *nepse.Quote) *Quote {
+	return &Quote{
+		SecurityId:      m.SecurityID,
+		Symbol:          text(m.Symbol),
+		SecurityName:    text(m.SecurityName),
+		Open:            m.Open,
+		High:            m.High,
+		Low:             m.Low,
+		LastTradedPrice: m.LastTradedPrice,
+		PreviousClose:   m.PreviousClose,
+		Change:          m.Change,
+		PercentChange:   m.PercentChange,
+		Volume:          m.Volume,
+		Turnover:        m.Turnover,
+		Trades:          m.Trades,
+		AsOf:            timestampToProto(m.AsOf.Time),
+		Source:          text(string(m.Source)),
+	}
+}
+
+// QuoteFromProto converts a message to a quote
+func QuoteFromProto(p *Quote) nepse.Quote {
+	if p == nil {
+		return nepse.Quote{}
+	}
+	return nepse.Quote{
+		SecurityID:      p.SecurityId,
+		Symbol:          p.Symbol,
+		SecurityName:    p.SecurityName,
+		Open:            p.Open,
+		High:            p.High,
+		Low:             p.Low,
+		LastTradedPrice: p.LastTradedPrice,
+		PreviousClose:   p.PreviousClose,
+		Change:          p.Change,
+		PercentChange:   p.PercentChange,
+		Volume:          p.Volume,
+		Turnover:        p.Turnover,
+		Trades:          p.Trades,
+		AsOf:            timestampFromProto(p.AsOf),
+		Source:          nepse.QuoteSource(p.Source),
+	}
+}
+
+// MarketSummaryToProto converts a market summary to its message
+func MarketSummaryToProto(m *nepse.MarketSummary) *MarketSummary {
+	return &MarketSummary{
+		TotalTurnover:             m.TotalTurnover,
+		TotalTradedShares:         m.TotalTradedShares,
+		TotalTransactions:         m.TotalTransactions,
+		TotalScripsTraded:         m.TotalScripsTraded,
+		TotalMarketCapitalization: m.TotalMarketCapitalization,
+		TotalFloatMarketCap:       m.TotalFloatMarketCap,
+	}
+}
+
+// MarketSummaryFromProto converts a message to a market summary
+func MarketSummaryFromProto(p *MarketSummary) nepse.MarketSummary {
+	if p == nil {
+		return nepse.MarketSummary{}
+	}
+	return nepse.MarketSummary{
+		TotalTurnover:             p.TotalTurnover,
+		TotalTradedShares:         p.TotalTradedShares,
+		TotalTransactions:         p.TotalTransactions,
+		TotalScripsTraded:         p.TotalScripsTraded,
+		TotalMarketCapitalization: p.TotalMarketCapitalization,
+		TotalFloatMarketCap:       p.TotalFloatMarketCap,
+	}
+}
+
+// SecurityToProto converts a security to its message
+func SecurityToProto(m *nepse.Security) *Security {
+	return &Security{
+		Id:                   m.ID,
+		Symbol:               text(m.Symbol),
+		SecurityName:         text(m.SecurityName),
+		IsSuspended:          m.IsSuspended,
+		SectorName:           text(m.SectorName),
+		Instrument:           text(string(m.Instrument)),
+		RegulatoryCategoryId: m.RegulatoryCategoryID,
+		ShareGroupId:         m.ShareGroupID,
+		ActiveStatus:         text(m.ActiveStatus),
+		ListingDate:          text(m.ListingDate),
+		SuspensionReason:     text(m.SuspensionReason),
+		SuspendedSince:       text(m.SuspendedSince),
+	}
+}
+
+// SecurityFromProto converts a message to a security
+func SecurityFromProto(p *Security) nepse.Security {
+	if p == nil {
+		return nepse.Security{}
+	}
+	return nepse.Security{
+		ID:                   p.Id,
+		Symbol:               p.Symbol,
+		SecurityName:         p.SecurityName,
+		IsSuspended:          p.IsSuspended,
+		SectorName:           p.SectorName,
+		Instrument:           nepse.InstrumentType(p.Instrument),
+		RegulatoryCategoryID: p.RegulatoryCategoryId,
+		ShareGroupID:         p.ShareGroupId,
+		ActiveStatus:         p.ActiveStatus,
+		ListingDate:          p.ListingDate,
+		SuspensionReason:     p.SuspensionReason,
+		SuspendedSince:       p.SuspendedSince,
+	}
+}
+
+// TodayPriceToProto converts a day's price to its message
+func TodayPriceToProto(m *nepse.TodayPrice) *TodayPrice {
+	return &TodayPrice{
+		Id:                    m.ID,
+		Symbol:                text(m.Symbol),
+		SecurityName:          text(m.SecurityName),
+		OpenPrice:             m.OpenPrice,
+		HighPrice:             m.HighPrice,
+		LowPrice:              m.LowPrice,
+		ClosePrice:            m.ClosePrice,
+		TotalTradedQuantity:   m.TotalTradedQuantity,
+		TotalTradedValue:      m.TotalTradedValue,
+		PreviousClose:         m.PreviousClose,
+		DifferenceRs:          m.DifferenceRs,
+		PercentageChange:      m.PercentageChange.Ptr(),
+		TotalTrades:           m.TotalTrades,
+		BusinessDate:          timestampToProto(m.BusinessDate.Time),
+		SecurityId:            m.SecurityID,
+		LastTradedPrice:       m.LastTradedPrice,
+		MaxPrice:              m.MaxPrice,
+		MinPrice:              m.MinPrice,
+		AverageTradedPrice:    m.AverageTradedPrice,
+		MarketCapitalization:  m.MarketCapitalization,
+		FiftyTwoWeekHigh:      m.FiftyTwoWeekHigh,
+		FiftyTwoWeekLow:       m.FiftyTwoWeekLow,
+		LastUpdatedPrice:      m.LastUpdatedPrice,
+		LastUpdatedTime:       timestampToProto(m.LastUpdatedTime.Time),
+		PreviousDayClosePrice: m.PreviousDayClosePrice,
+	}
+}
+
+// TodayPriceFromProto converts a message to a day's price
+func TodayPriceFromProto(p *TodayPrice) nepse.TodayPrice {
+	if p == nil {
+		return nepse.TodayPrice{}
+	}
+	return nepse.TodayPrice{
+		ID:                    p.Id,
+		Symbol:                p.Symbol,
+		SecurityName:          p.SecurityName,
+		OpenPrice:             p.OpenPrice,
+		HighPrice:             p.HighPrice,
+		LowPrice:              p.LowPrice,
+		ClosePrice:            p.ClosePrice,
+		TotalTradedQuantity:   p.TotalTradedQuantity,
+		TotalTradedValue:      p.TotalTradedValue,
+		PreviousClose:         p.PreviousClose,
+		DifferenceRs:          p.DifferenceRs,
+		PercentageChange:      nullFromProto(p.PercentageChange),
+		TotalTrades:           p.TotalTrades,
+		BusinessDate:          timestampFromProto(p.BusinessDate),
+		SecurityID:            p.SecurityId,
+		LastTradedPrice:       p.LastTradedPrice,
+		MaxPrice:              p.MaxPrice,
+		MinPrice:              p.MinPrice,
+		AverageTradedPrice:    p.AverageTradedPrice,
+		MarketCapitalization:  p.MarketCapitalization,
+		FiftyTwoWeekHigh:      p.FiftyTwoWeekHigh,
+		FiftyTwoWeekLow:       p.FiftyTwoWeekLow,
+		LastUpdatedPrice:      p.LastUpdatedPrice,
+		LastUpdatedTime:       timestampFromProto(p.LastUpdatedTime),
+		PreviousDayClosePrice: p.PreviousDayClosePrice,
+	}
+}
+
+// PriceHistoryToProto converts a day of price history to its message
+func PriceHistoryToProto(m *nepse.PriceHistory) *PriceHistory {
+	return &PriceHistory{
+		BusinessDate:        timestampToProto(m.BusinessDate.Time),
+		SecurityId:          m.SecurityID,
+		Symbol:              text(m.Symbol),
+		SecurityName:        text(m.SecurityName),
+		OpenPrice:           m.OpenPrice,
+		HighPrice:           m.HighPrice,
+		LowPrice:            m.LowPrice,
+		ClosePrice:          m.ClosePrice,
+		TotalTradedQuantity: m.TotalTradedQuantity,
+		TotalTradedValue:    m.TotalTradedValue,
+		TotalTrades:         m.TotalTrades,
+		PreviousClose:       m.PreviousClose,
+		DifferenceRs:        m.DifferenceRs,
+		PercentageChange:    m.PercentageChange.Ptr(),
+	}
+}
+
+// PriceHistoryFromProto converts a message to a day of price history
+func PriceHistoryFromProto(p *PriceHistory) nepse.PriceHistory {
+	if p == nil {
+		return nepse.PriceHistory{}
+	}
+	return nepse.PriceHistory{
+		BusinessDate:        timestampFromProto(p.BusinessDate),
+		SecurityID:          p.SecurityId,
+		Symbol:              p.Symbol,
+		SecurityName:        p.SecurityName,
+		OpenPrice:           p.OpenPrice,
+		HighPrice:           p.HighPrice,
+		LowPrice:            p.LowPrice,
+		ClosePrice:          p.ClosePrice,
+		TotalTradedQuantity: p.TotalTradedQuantity,
+		TotalTradedValue:    p.TotalTradedValue,
+		TotalTrades:         p.TotalTrades,
+		PreviousClose:       p.PreviousClose,
+		DifferenceRs:        p.DifferenceRs,
+		PercentageChange:    nullFromProto(p.PercentageChange),
+	}
+}
+
+// FloorSheetEntryToProto converts a trade to its message
+func FloorSheetEntryToProto(m *nepse.FloorSheetEntry) *FloorSheetEntry {
+	return &FloorSheetEntry{
+		ContractId:       m.ContractID,
+		StockSymbol:      text(m.StockSymbol),
+		SecurityName:     text(m.SecurityName),
+		BuyerMemberId:    m.BuyerMemberID,
+		SellerMemberId:   m.SellerMemberID,
+		ContractQuantity: m.ContractQuantity,
+		ContractRate:     m.ContractRate,
+		BusinessDate:     timestampToProto(m.BusinessDate.Time),
+		TradeTime:        text(m.TradeTime),
+		SecurityId:       m.SecurityID,
+		ContractAmount:   m.ContractAmount,
+		BuyerBrokerName:  text(m.BuyerBrokerName),
+		SellerBrokerName: text(m.SellerBrokerName),
+		TradeBookId:      m.TradeBookID,
+	}
+}
+
+// FloorSheetEntryFromProto converts a message to a trade
+func FloorSheetEntryFromProto(p *FloorSheetEntry) nepse.FloorSheetEntry {
+	if p == nil {
+		return nepse.FloorSheetEntry{}
+	}
+	return nepse.FloorSheetEntry{
+		ContractID:       p.ContractId,
+		StockSymbol:      p.StockSymbol,
+		SecurityName:     p.SecurityName,
+		BuyerMemberID:    p.BuyerMemberId,
+		SellerMemberID:   p.SellerMemberId,
+		ContractQuantity: p.ContractQuantity,
+		ContractRate:     p.ContractRate,
+		BusinessDate:     timestampFromProto(p.BusinessDate),
+		TradeTime:        p.TradeTime,
+		SecurityID:       p.SecurityId,
+		ContractAmount:   p.ContractAmount,
+		BuyerBrokerName:  p.BuyerBrokerName,
+		SellerBrokerName: p.SellerBrokerName,
+		TradeBookID:      p.TradeBookId,
+	}
+}
+
+// TopListEntryToProto converts a top list entry to its message. The columns
+// particular to one top list (LTP, ClosingPrice, ShareTraded...) are not
+// carried; their values are in the common fields.
+func TopListEntryToProto(m *nepse.TopListEntry) *TopListEntry {
+	return &TopListEntry{
+		Symbol:              text(m.Symbol),
+		SecurityName:        text(m.SecurityName),
+		ClosePrice:          m.ClosePrice,
+		PercentageChange:    m.PercentageChange.Ptr(),
+		DifferenceRs:        m.DifferenceRs,
+		TotalTradedQuantity: m.TotalTradedQuantity,
+		TotalTradedValue:    m.TotalTradedValue,
+		TotalTrades:         m.TotalTrades,
+		HighPrice:           m.HighPrice,
+		LowPrice:            m.LowPrice,
+		OpenPrice:           m.OpenPrice,
+		PreviousClose:       m.PreviousClose,
+		SecurityId:          m.SecurityID,
+	}
+}
+
+// TopListEntryFromProto converts a message to a top list entry
+func TopListEntryFromProto(p *TopListEntry) nepse.TopListEntry {
+	if p == nil {
+		return nepse.TopListEntry{}
+	}
+	return nepse.TopListEntry{
+		Symbol:              p.Symbol,
+		SecurityName:        p.SecurityName,
+		ClosePrice:          p.ClosePrice,
+		PercentageChange:    nullFromProto(p.PercentageChange),
+		DifferenceRs:        p.DifferenceRs,
+		TotalTradedQuantity: p.TotalTradedQuantity,
+		TotalTradedValue:    p.TotalTradedValue,
+		TotalTrades:         p.TotalTrades,
+		HighPrice:           p.HighPrice,
+		LowPrice:            p.LowPrice,
+		OpenPrice:           p.OpenPrice,
+		PreviousClose:       p.PreviousClose,
+		SecurityID:          p.SecurityId,
+	}
+}
+
+// LiveMarketEntryToProto converts a live market entry to its message
+func LiveMarketEntryToProto(m *nepse.LiveMarketEntry) *LiveMarketEntry {
+	return &LiveMarketEntry{
+		Symbol:           text(m.Symbol),
+		SecurityName:     text(m.SecurityName),
+		OpenPrice:        m.OpenPrice,
+		HighPrice:        m.HighPrice,
+		LowPrice:         m.LowPrice,
+		ClosePrice:       m.ClosePrice,
+		PercentChange:    m.PercentChange.Ptr(),
+		Volume:           m.Volume,
+		PreviousClose:    m.PreviousClose,
+		LastTradedVolume: m.LastTradedVolume,
+	}
+}
+
+// LiveMarketEntryFromProto converts a message to a live market entry
+func LiveMarketEntryFromProto(p *LiveMarketEntry) nepse.LiveMarketEntry {
+	if p == nil {
+		return nepse.LiveMarketEntry{}
+	}
+	return nepse.LiveMarketEntry{
+		Symbol:           p.Symbol,
+		SecurityName:     p.SecurityName,
+		OpenPrice:        p.OpenPrice,
+		HighPrice:        p.HighPrice,
+		LowPrice:         p.LowPrice,
+		ClosePrice:       p.ClosePrice,
+		PercentChange:    nullFromProto(p.PercentChange),
+		Volume:           p.Volume,
+		PreviousClose:    p.PreviousClose,
+		LastTradedVolume: p.LastTradedVolume,
+	}
+}
+
+// depthLevel is the element type of MarketDepth.BuyDepth and SellDepth
+type depthLevel = struct {
+	Price    float64 `json:"price"`
+	Quantity int64   `json:"quantity"`
+	Orders   int32   `json:"orders"`
+}
+
+// MarketDepthToProto converts market depth to its message
+func MarketDepthToProto(m *nepse.MarketDepth) *MarketDepth {
+	return &MarketDepth{
+		SecurityId:        m.SecurityID,
+		Symbol:            text(m.Symbol),
+		SecurityName:      text(m.SecurityName),
+		BuyDepth:          depthToProto(m.BuyDepth),
+		SellDepth:         depthToProto(m.SellDepth),
+		TotalBuyQuantity:  m.TotalBuyQuantity,
+		TotalSellQuantity: m.TotalSellQuantity,
+	}
+}
+
+// MarketDepthFromProto converts a message to market depth
+func MarketDepthFromProto(p *MarketDepth) nepse.MarketDepth {
+	if p == nil {
+		return nepse.MarketDepth{}
+	}
+	return nepse.MarketDepth{
+		SecurityID:        p.SecurityId,
+		Symbol:            p.Symbol,
+		SecurityName:      p.SecurityName,
+		BuyDepth:          depthFromProto(p.BuyDepth),
+		SellDepth:         depthFromProto(p.SellDepth),
+		TotalBuyQuantity:  p.TotalBuyQuantity,
+		TotalSellQuantity: p.TotalSellQuantity,
+	}
+}
+
+func depthToProto(levels []depthLevel) []*DepthLevel {
+	if levels == nil {
+		return nil
+	}
+	out := make([]*DepthLevel, len(levels))
+	for i, l := range levels {
+		out[i] = &DepthLevel{Price: l.Price, Quantity: l.Quantity, Orders: l.Orders}
+	}
+	return out
+}
+
+func depthFromProto(levels []*DepthLevel) []depthLevel {
+	if levels == nil {
+		return nil
+	}
+	out := make([]depthLevel, len(levels))
+	for i, l := range levels {
+		out[i] = depthLevel{Price: l.GetPrice(), Quantity: l.GetQuantity(), Orders: l.GetOrders()}
+	}
+	return out
+}
+
+// NepseIndexToProto converts an index to its message
+func NepseIndexToProto(m *nepse.NepseIndex) *NepseIndex {
+	return &NepseIndex{
+		IndexValue:       m.IndexValue,
+		PercentChange:    m.PercentChange,
+		PointChange:      m.PointChange,
+		High:             m.High,
+		Low:              m.Low,
+		PreviousClose:    m.PreviousClose,
+		FiftyTwoWeekHigh: m.FiftyTwoWeekHigh,
+		FiftyTwoWeekLow:  m.FiftyTwoWeekLow,
+		CurrentValue:     m.CurrentValue,
+		GeneratedTime:    timestampToProto(m.GeneratedTime.Time),
+	}
+}
+
+// NepseIndexFromProto converts a message to an index
+func NepseIndexFromProto(p *NepseIndex) nepse.NepseIndex {
+	if p == nil {
+		return nepse.NepseIndex{}
+	}
+	return nepse.NepseIndex{
+		IndexValue:       p.IndexValue,
+		PercentChange:    p.PercentChange,
+		PointChange:      p.PointChange,
+		High:             p.High,
+		Low:              p.Low,
+		PreviousClose:    p.PreviousClose,
+		FiftyTwoWeekHigh: p.FiftyTwoWeekHigh,
+		FiftyTwoWeekLow:  p.FiftyTwoWeekLow,
+		CurrentValue:     p.CurrentValue,
+		GeneratedTime:    timestampFromProto(p.GeneratedTime),
+	}
+}
+
+// MarketStatusToProto converts a market status to its message
+func MarketStatusToProto(m *nepse.MarketStatus) *MarketStatus {
+	return &MarketStatus{
+		IsOpen: text(string(m.IsOpen)),
+		AsOf:   timestampToProto(m.AsOf.Time),
+		Id:     m.ID,
+	}
+}
+
+// MarketStatusFromProto converts a message to a market status
+func MarketStatusFromProto(p *MarketStatus) nepse.MarketStatus {
+	if p == nil {
+		return nepse.MarketStatus{}
+	}
+	return nepse.MarketStatus{
+		IsOpen: nepse.MarketState(p.IsOpen),
+		AsOf:   timestampFromProto(p.AsOf),
+		ID:     p.Id,
+	}
+}
+
+// PriceBandToProto converts a price band to its message
+func PriceBandToProto(m *nepse.PriceBand) *PriceBand {
+	return &PriceBand{
+		SecurityId:    m.SecurityID,
+		Symbol:        text(m.Symbol),
+		PreviousClose: m.PreviousClose,
+		Percent:       m.Percent,
+		TickSize:      m.TickSize,
+		Upper:         m.Upper,
+		Lower:         m.Lower,
+	}
+}
+
+// PriceBandFromProto converts a message to a price band
+func PriceBandFromProto(p *PriceBand) nepse.PriceBand {
+	if p == nil {
+		return nepse.PriceBand{}
+	}
+	return nepse.PriceBand{
+		SecurityID:    p.SecurityId,
+		Symbol:        p.Symbol,
+		PreviousClose: p.PreviousClose,
+		Percent:       p.Percent,
+		TickSize:      p.TickSize,
+		Upper:         p.Upper,
+		Lower:         p.Lower,
+	}
+}
+
+// text replaces invalid UTF-8, which proto3 strings cannot carry
+func text(s string) string {
+	return strings.ToValidUTF8(s, "�")
+}
+
+// timestampToProto converts a time, absent if zero
+func timestampToProto(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// timeFromProto converts a timestamp to Nepal time, zero if absent
+func timeFromProto(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime().In(nepse.NepalLocation)
+}
+
+// timestampFromProto converts a timestamp to Nepal time; the raw string NEPSE
+// sent is not carried
+func timestampFromProto(ts *timestamppb.Timestamp) nepse.Timestamp {
+	return nepse.Timestamp{Time: timeFromProto(ts)}
+}
+
+// nullFromProto converts a proto3 optional double
+func nullFromProto(v *float64) nepse.Null[float64] {
+	if v == nil {
+		return nepse.Null[float64]{}
+	}
+	return nepse.NullOf(*v)
+}
@@ -0,0 +1,2795 @@
+// Protobuf schema of the public NEPSE models, version 1.
+//
+// Field numbers are stable: new fields get new numbers and removed fields are
+// reserved, never reused. The nepsepb Go package holds the types generated
+// from this file and converters to and from the nepse models; services in
+// other languages generate their types from it too.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: nepse.proto
+
+package nepsepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Quote struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SecurityId      int32                  `protobuf:"varint,1,opt,name=security_id,json=securityId,proto3" json:"security_id,omitempty"`
+	Symbol          string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	SecurityName    string                 `protobuf:"bytes,3,opt,name=security_name,json=securityName,proto3" json:"security_name,omitempty"`
+	Open            float64                `protobuf:"fixed64,4,opt,name=open,proto3" json:"open,omitempty"`
+	High            float64                `protobuf:"fixed64,5,opt,name=high,proto3" json:"high,omitempty"`
+	Low             float64                `protobuf:"fixed64,6,opt,name=low,proto3" json:"low,omitempty"`
+	LastTradedPrice float64                `protobuf:"fixed64,7,opt,name=last_traded_price,json=lastTradedPrice,proto3" json:"last_traded_price,omitempty"`
+	PreviousClose   float64                `protobuf:"fixed64,8,opt,name=previous_close,json=previousClose,proto3" json:"previous_close,omitempty"`
+	Change          float64                `protobuf:"fixed64,9,opt,name=change,proto3" json:"change,omitempty"`
+	PercentChange   float64                `protobuf:"fixed64,10,opt,name=percent_change,json=percentChange,proto3" json:"percent_change,omitempty"`
+	Volume          int64                  `protobuf:"varint,11,opt,name=volume,proto3" json:"volume,omitempty"`
+	Turnover        float64                `protobuf:"fixed64,12,opt,name=turnover,proto3" json:"turnover,omitempty"`
+	Trades          int32                  `protobuf:"varint,13,opt,name=trades,proto3" json:"trades,omitempty"`
+	AsOf            *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	Source          string                 `protobuf:"bytes,15,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Quote) Reset() {
+	*x = Quote{}
+	mi := &file_nepse_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quote) ProtoMessage() {}
+
+func (x *Quote) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quote.ProtoReflect.Descriptor instead.
+func (*Quote) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Quote) GetSecurityId() int32 {
+	if x != nil {
+		return x.SecurityId
+	}
+	return 0
+}
+
+func (x *Quote) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Quote) GetSecurityName() string {
+	if x != nil {
+		return x.SecurityName
+	}
+	return ""
+}
+
+func (x *Quote) GetOpen() float64 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *Quote) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *Quote) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *Quote) GetLastTradedPrice() float64 {
+	if x != nil {
+		return x.LastTradedPrice
+	}
+	return 0
+}
+
+func (x *Quote) GetPreviousClose() float64 {
+	if x != nil {
+		return x.PreviousClose
+	}
+	return 0
+}
+
+func (x *Quote) GetChange() float64 {
+	if x != nil {
+		return x.Change
+	}
+	return 0
+}
+
+func (x *Quote) GetPercentChange() float64 {
+	if x != nil {
+		return x.PercentChange
+	}
+	return 0
+}
+
+func (x *Quote) GetVolume() int64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *Quote) GetTurnover() float64 {
+	if x != nil {
+		return x.Turnover
+	}
+	return 0
+}
+
+func (x *Quote) GetTrades() int32 {
+	if x != nil {
+		return x.Trades
+	}
+	return 0
+}
+
+func (x *Quote) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *Quote) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type MarketSummary struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	TotalTurnover             float64                `protobuf:"fixed64,1,opt,name=total_turnover,json=totalTurnover,proto3" json:"total_turnover,omitempty"`
+	TotalTradedShares         float64                `protobuf:"fixed64,2,opt,name=total_traded_shares,json=totalTradedShares,proto3" json:"total_traded_shares,omitempty"`
+	TotalTransactions         float64                `protobuf:"fixed64,3,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions,omitempty"`
+	TotalScripsTraded         float64                `protobuf:"fixed64,4,opt,name=total_scrips_traded,json=totalScripsTraded,proto3" json:"total_scrips_traded,omitempty"`
+	TotalMarketCapitalization float64                `protobuf:"fixed64,5,opt,name=total_market_capitalization,json=totalMarketCapitalization,proto3" json:"total_market_capitalization,omitempty"`
+	TotalFloatMarketCap       float64                `protobuf:"fixed64,6,opt,name=total_float_market_cap,json=totalFloatMarketCap,proto3" json:"total_float_market_cap,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *MarketSummary) Reset() {
+	*x = MarketSummary{}
+	mi := &file_nepse_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketSummary) ProtoMessage() {}
+
+func (x *MarketSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketSummary.ProtoReflect.Descriptor instead.
+func (*MarketSummary) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MarketSummary) GetTotalTurnover() float64 {
+	if x != nil {
+		return x.TotalTurnover
+	}
+	return 0
+}
+
+func (x *MarketSummary) GetTotalTradedShares() float64 {
+	if x != nil {
+		return x.TotalTradedShares
+	}
+	return 0
+}
+
+func (x *MarketSummary) GetTotalTransactions() float64 {
+	if x != nil {
+		return x.TotalTransactions
+	}
+	return 0
+}
+
+func (x *MarketSummary) GetTotalScripsTraded() float64 {
+	if x != nil {
+		return x.TotalScripsTraded
+	}
+	return 0
+}
+
+func (x *MarketSummary) GetTotalMarketCapitalization() float64 {
+	if x != nil {
+		return x.TotalMarketCapitalization
+	}
+	return 0
+}
+
+func (x *MarketSummary) GetTotalFloatMarketCap() float64 {
+	if x != nil {
+		return x.TotalFloatMarketCap
+	}
+	return 0
+}
+
+type Security struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Symbol               string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	SecurityName         string                 `protobuf:"bytes,3,opt,name=security_name,json=securityName,proto3" json:"security_name,omitempty"`
+	IsSuspended          bool                   `protobuf:"varint,4,opt,name=is_suspended,json=isSuspended,proto3" json:"is_suspended,omitempty"`
+	SectorName           string                 `protobuf:"bytes,5,opt,name=sector_name,json=sectorName,proto3" json:"sector_name,omitempty"`
+	Instrument           string                 `protobuf:"bytes,6,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	RegulatoryCategoryId int32                  `protobuf:"varint,7,opt,name=regulatory_category_id,json=regulatoryCategoryId,proto3" json:"regulatory_category_id,omitempty"`
+	ShareGroupId         int32                  `protobuf:"varint,8,opt,name=share_group_id,json=shareGroupId,proto3" json:"share_group_id,omitempty"`
+	ActiveStatus         string                 `protobuf:"bytes,9,opt,name=active_status,json=activeStatus,proto3" json:"active_status,omitempty"`
+	ListingDate          string                 `protobuf:"bytes,10,opt,name=listing_date,json=listingDate,proto3" json:"listing_date,omitempty"`
+	SuspensionReason     string                 `protobuf:"bytes,11,opt,name=suspension_reason,json=suspensionReason,proto3" json:"suspension_reason,omitempty"`
+	SuspendedSince       string                 `protobuf:"bytes,12,opt,name=suspended_since,json=suspendedSince,proto3" json:"suspended_since,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Security) Reset() {
+	*x = Security{}
+	mi := &file_nepse_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Security) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Security) ProtoMessage() {}
+
+func (x *Security) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Security.ProtoReflect.Descriptor instead.
+func (*Security) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Security) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Security) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Security) GetSecurityName() string {
+	if x != nil {
+		return x.SecurityName
+	}
+	return ""
+}
+
+func (x *Security) GetIsSuspended() bool {
+	if x != nil {
+		return x.IsSuspended
+	}
+	return false
+}
+
+func (x *Security) GetSectorName() string {
+	if x != nil {
+		return x.SectorName
+	}
+	return ""
+}
+
+func (x *Security) GetInstrument() string {
+	if x != nil {
+		return x.Instrument
+	}
+	return ""
+}
+
+func (x *Security) GetRegulatoryCategoryId() int32 {
+	if x != nil {
+		return x.RegulatoryCategoryId
+	}
+	return 0
+}
+
+func (x *Security) GetShareGroupId() int32 {
+	if x != nil {
+		return x.ShareGroupId
+	}
+	return 0
+}
+
+func (x *Security) GetActiveStatus() string {
+	if x != nil {
+		return x.ActiveStatus
+	}
+	return ""
+}
+
+func (x *Security) GetListingDate() string {
+	if x != nil {
+		return x.ListingDate
+	}
+	return ""
+}
+
+func (x *Security) GetSuspensionReason() string {
+	if x != nil {
+		return x.SuspensionReason
+	}
+	return ""
+}
+
+func (x *Security) GetSuspendedSince() string {
+	if x != nil {
+		return x.SuspendedSince
+	}
+	return ""
+}
+
+type TodayPrice struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Symbol                string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	SecurityName          string                 `protobuf:"bytes,3,opt,name=security_name,json=securityName,proto3" json:"security_name,omitempty"`
+	OpenPrice             float64                `protobuf:"fixed64,4,opt,name=open_price,json=openPrice,proto3" json:"open_price,omitempty"`
+	HighPrice             float64                `protobuf:"fixed64,5,opt,name=high_price,json=highPrice,proto3" json:"high_price,omitempty"`
+	LowPrice              float64                `protobuf:"fixed64,6,opt,name=low_price,json=lowPrice,proto3" json:"low_price,omitempty"`
+	ClosePrice            float64                `protobuf:"fixed64,7,opt,name=close_price,json=closePrice,proto3" json:"close_price,omitempty"`
+	TotalTradedQuantity   int64                  `protobuf:"varint,8,opt,name=total_traded_quantity,json=totalTradedQuantity,proto3" json:"total_traded_quantity,omitempty"`
+	TotalTradedValue      float64                `protobuf:"fixed64,9,opt,name=total_traded_value,json=totalTradedValue,proto3" json:"total_traded_value,omitempty"`
+	PreviousClose         float64                `protobuf:"fixed64,10,opt,name=previous_close,json=previousClose,proto3" json:"previous_close,omitempty"`
+	DifferenceRs          float64                `protobuf:"fixed64,11,opt,name=difference_rs,json=differenceRs,proto3" json:"difference_rs,omitempty"`
+	PercentageChange      *float64               `protobuf:"fixed64,12,opt,name=percentage_change,json=percentageChange,proto3,oneof" json:"percentage_change,omitempty"`
+	TotalTrades           int32                  `protobuf:"varint,13,opt,name=total_trades,json=totalTrades,proto3" json:"total_trades,omitempty"`
+	BusinessDate          *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=business_date,json=businessDate,proto3" json:"business_date,omitempty"`
+	SecurityId            int32                  `protobuf:"varint,15,opt,name=security_id,json=securityId,proto3" json:"security_id,omitempty"`
+	LastTradedPrice       float64                `protobuf:"fixed64,16,opt,name=last_traded_price,json=lastTradedPrice,proto3" json:"last_traded_price,omitempty"`
+	MaxPrice              float64                `protobuf:"fixed64,17,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	MinPrice              float64                `protobuf:"fixed64,18,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	AverageTradedPrice    float64                `protobuf:"fixed64,19,opt,name=average_traded_price,json=averageTradedPrice,proto3" json:"average_traded_price,omitempty"`
+	MarketCapitalization  float64                `protobuf:"fixed64,20,opt,name=market_capitalization,json=marketCapitalization,proto3" json:"market_capitalization,omitempty"`
+	FiftyTwoWeekHigh      float64                `protobuf:"fixed64,21,opt,name=fifty_two_week_high,json=fiftyTwoWeekHigh,proto3" json:"fifty_two_week_high,omitempty"`
+	FiftyTwoWeekLow       float64                `protobuf:"fixed64,22,opt,name=fifty_two_week_low,json=fiftyTwoWeekLow,proto3" json:"fifty_two_week_low,omitempty"`
+	LastUpdatedPrice      float64                `protobuf:"fixed64,23,opt,name=last_updated_price,json=lastUpdatedPrice,proto3" json:"last_updated_price,omitempty"`
+	LastUpdatedTime       *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=last_updated_time,json=lastUpdatedTime,proto3" json:"last_updated_time,omitempty"`
+	PreviousDayClosePrice float64                `protobuf:"fixed64,25,opt,name=previous_day_close_price,json=previousDayClosePrice,proto3" json:"previous_day_close_price,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *TodayPrice) Reset() {
+	*x = TodayPrice{}
+	mi := &file_nepse_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TodayPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TodayPrice) ProtoMessage() {}
+
+func (x *TodayPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TodayPrice.ProtoReflect.Descriptor instead.
+func (*TodayPrice) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TodayPrice) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TodayPrice) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *TodayPrice) GetSecurityName() string {
+	if x != nil {
+		return x.SecurityName
+	}
+	return ""
+}
+
+func (x *TodayPrice) GetOpenPrice() float64 {
+	if x != nil {
+		return x.OpenPrice
+	}
+	return 0
+}
+
+func (x *TodayPrice) GetHighPrice() float64 {
+	if x != nil {
+		return x.HighPrice
+	}
+	return 0
+}
+
+func (x *TodayPrice) GetLowPrice() float64 {
+	if x != nil {
+		return x.LowPrice
+	}
+	return 0
+}
+
+func (x *TodayPrice) GetClosePrice() float64 {
+	if x != nil {
+		return x.ClosePrice
+	}
+	return 0
+}
+
+func (x *TodayPrice) GetTotalTradedQuantity() int64 {
+	if x != nil {
+		return x.TotalTradedQuantity
+	}
+	return 0
+}
+
+func (x *TodayPrice) GetTotalTradedValue() float64 {
+	if x != nil {
+		return x.TotalTradedValue
+	}
+	return 0
+}
+
+func (x *TodayPrice) GetPreviousClose() float64 {
+	if x != nil {
+		return x.PreviousClose
+	}
+	return 0
+}
+
+func (x *TodayPrice) GetDifferenceRs() float64 {
+	if x != nil {
+		return x.DifferenceRs
+	}
+	return 0
+}
+
+func (x *TodayPrice) GetPercentageChange() float64 {
+	if x != nil && x.PercentageChange != nil {
+		return *x.PercentageChange
+	}
+	return 0
+}
+
+func (x *TodayPrice) GetTotalTrades() int32 {
+	if x != nil {
+		return x.TotalTrades
+	}
+	return 0
+}
+
+func (x *TodayPrice) GetBusinessDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BusinessDate
+	}
+	return nil
+}
+
+func (x *TodayPrice) GetSecurityId() int32 {
+	if x != nil {
+		return x.SecurityId
+	}
+	return 0
+}
+
+func (x *TodayPrice) GetLastTradedPrice() float64 {
+	if x != nil {
+		return x.LastTradedPrice
+	}
+	return 0
+}
+
+func (x *TodayPrice) GetMaxPrice() float64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *TodayPrice) GetMinPrice() float64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *TodayPrice) GetAverageTradedPrice() float64 {
+	if x != nil {
+		return x.AverageTradedPrice
+	}
+	return 0
+}
+
+func (x *TodayPrice) GetMarketCapitalization() float64 {
+	if x != nil {
+		return x.MarketCapitalization
+	}
+	return 0
+}
+
+func (x *TodayPrice) GetFiftyTwoWeekHigh() float64 {
+	if x != nil {
+		return x.FiftyTwoWeekHigh
+	}
+	return 0
+}
+
+func (x *TodayPrice) GetFiftyTwoWeekLow() float64 {
+	if x != nil {
+		return x.FiftyTwoWeekLow
+	}
+	return 0
+}
+
+func (x *TodayPrice) GetLastUpdatedPrice() float64 {
+	if x != nil {
+		return x.LastUpdatedPrice
+	}
+	return 0
+}
+
+func (x *TodayPrice) GetLastUpdatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdatedTime
+	}
+	return nil
+}
+
+func (x *TodayPrice) GetPreviousDayClosePrice() float64 {
+	if x != nil {
+		return x.PreviousDayClosePrice
+	}
+	return 0
+}
+
+type PriceHistory struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	BusinessDate        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=business_date,json=businessDate,proto3" json:"business_date,omitempty"`
+	SecurityId          int32                  `protobuf:"varint,2,opt,name=security_id,json=securityId,proto3" json:"security_id,omitempty"`
+	Symbol              string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	SecurityName        string                 `protobuf:"bytes,4,opt,name=security_name,json=securityName,proto3" json:"security_name,omitempty"`
+	OpenPrice           float64                `protobuf:"fixed64,5,opt,name=open_price,json=openPrice,proto3" json:"open_price,omitempty"`
+	HighPrice           float64                `protobuf:"fixed64,6,opt,name=high_price,json=highPrice,proto3" json:"high_price,omitempty"`
+	LowPrice            float64                `protobuf:"fixed64,7,opt,name=low_price,json=lowPrice,proto3" json:"low_price,omitempty"`
+	ClosePrice          float64                `protobuf:"fixed64,8,opt,name=close_price,json=closePrice,proto3" json:"close_price,omitempty"`
+	TotalTradedQuantity int64                  `protobuf:"varint,9,opt,name=total_traded_quantity,json=totalTradedQuantity,proto3" json:"total_traded_quantity,omitempty"`
+	TotalTradedValue    float64                `protobuf:"fixed64,10,opt,name=total_traded_value,json=totalTradedValue,proto3" json:"total_traded_value,omitempty"`
+	TotalTrades         int32                  `protobuf:"varint,11,opt,name=total_trades,json=totalTrades,proto3" json:"total_trades,omitempty"`
+	PreviousClose       float64                `protobuf:"fixed64,12,opt,name=previous_close,json=previousClose,proto3" json:"previous_close,omitempty"`
+	DifferenceRs        float64                `protobuf:"fixed64,13,opt,name=difference_rs,json=differenceRs,proto3" json:"difference_rs,omitempty"`
+	PercentageChange    *float64               `protobuf:"fixed64,14,opt,name=percentage_change,json=percentageChange,proto3,oneof" json:"percentage_change,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PriceHistory) Reset() {
+	*x = PriceHistory{}
+	mi := &file_nepse_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceHistory) ProtoMessage() {}
+
+func (x *PriceHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceHistory.ProtoReflect.Descriptor instead.
+func (*PriceHistory) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PriceHistory) GetBusinessDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BusinessDate
+	}
+	return nil
+}
+
+func (x *PriceHistory) GetSecurityId() int32 {
+	if x != nil {
+		return x.SecurityId
+	}
+	return 0
+}
+
+func (x *PriceHistory) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *PriceHistory) GetSecurityName() string {
+	if x != nil {
+		return x.SecurityName
+	}
+	return ""
+}
+
+func (x *PriceHistory) GetOpenPrice() float64 {
+	if x != nil {
+		return x.OpenPrice
+	}
+	return 0
+}
+
+func (x *PriceHistory) GetHighPrice() float64 {
+	if x != nil {
+		return x.HighPrice
+	}
+	return 0
+}
+
+func (x *PriceHistory) GetLowPrice() float64 {
+	if x != nil {
+		return x.LowPrice
+	}
+	return 0
+}
+
+func (x *PriceHistory) GetClosePrice() float64 {
+	if x != nil {
+		return x.ClosePrice
+	}
+	return 0
+}
+
+func (x *PriceHistory) GetTotalTradedQuantity() int64 {
+	if x != nil {
+		return x.TotalTradedQuantity
+	}
+	return 0
+}
+
+func (x *PriceHistory) GetTotalTradedValue() float64 {
+	if x != nil {
+		return x.TotalTradedValue
+	}
+	return 0
+}
+
+func (x *PriceHistory) GetTotalTrades() int32 {
+	if x != nil {
+		return x.TotalTrades
+	}
+	return 0
+}
+
+func (x *PriceHistory) GetPreviousClose() float64 {
+	if x != nil {
+		return x.PreviousClose
+	}
+	return 0
+}
+
+func (x *PriceHistory) GetDifferenceRs() float64 {
+	if x != nil {
+		return x.DifferenceRs
+	}
+	return 0
+}
+
+func (x *PriceHistory) GetPercentageChange() float64 {
+	if x != nil && x.PercentageChange != nil {
+		return *x.PercentageChange
+	}
+	return 0
+}
+
+type FloorSheetEntry struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ContractId       int64                  `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	StockSymbol      string                 `protobuf:"bytes,2,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	SecurityName     string                 `protobuf:"bytes,3,opt,name=security_name,json=securityName,proto3" json:"security_name,omitempty"`
+	BuyerMemberId    int32                  `protobuf:"varint,4,opt,name=buyer_member_id,json=buyerMemberId,proto3" json:"buyer_member_id,omitempty"`
+	SellerMemberId   int32                  `protobuf:"varint,5,opt,name=seller_member_id,json=sellerMemberId,proto3" json:"seller_member_id,omitempty"`
+	ContractQuantity int64                  `protobuf:"varint,6,opt,name=contract_quantity,json=contractQuantity,proto3" json:"contract_quantity,omitempty"`
+	ContractRate     float64                `protobuf:"fixed64,7,opt,name=contract_rate,json=contractRate,proto3" json:"contract_rate,omitempty"`
+	BusinessDate     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=business_date,json=businessDate,proto3" json:"business_date,omitempty"`
+	TradeTime        string                 `protobuf:"bytes,9,opt,name=trade_time,json=tradeTime,proto3" json:"trade_time,omitempty"`
+	SecurityId       int32                  `protobuf:"varint,10,opt,name=security_id,json=securityId,proto3" json:"security_id,omitempty"`
+	ContractAmount   float64                `protobuf:"fixed64,11,opt,name=contract_amount,json=contractAmount,proto3" json:"contract_amount,omitempty"`
+	BuyerBrokerName  string                 `protobuf:"bytes,12,opt,name=buyer_broker_name,json=buyerBrokerName,proto3" json:"buyer_broker_name,omitempty"`
+	SellerBrokerName string                 `protobuf:"bytes,13,opt,name=seller_broker_name,json=sellerBrokerName,proto3" json:"seller_broker_name,omitempty"`
+	TradeBookId      int64                  `protobuf:"varint,14,opt,name=trade_book_id,json=tradeBookId,proto3" json:"trade_book_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FloorSheetEntry) Reset() {
+	*x = FloorSheetEntry{}
+	mi := &file_nepse_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FloorSheetEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloorSheetEntry) ProtoMessage() {}
+
+func (x *FloorSheetEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloorSheetEntry.ProtoReflect.Descriptor instead.
+func (*FloorSheetEntry) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FloorSheetEntry) GetContractId() int64 {
+	if x != nil {
+		return x.ContractId
+	}
+	return 0
+}
+
+func (x *FloorSheetEntry) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *FloorSheetEntry) GetSecurityName() string {
+	if x != nil {
+		return x.SecurityName
+	}
+	return ""
+}
+
+func (x *FloorSheetEntry) GetBuyerMemberId() int32 {
+	if x != nil {
+		return x.BuyerMemberId
+	}
+	return 0
+}
+
+func (x *FloorSheetEntry) GetSellerMemberId() int32 {
+	if x != nil {
+		return x.SellerMemberId
+	}
+	return 0
+}
+
+func (x *FloorSheetEntry) GetContractQuantity() int64 {
+	if x != nil {
+		return x.ContractQuantity
+	}
+	return 0
+}
+
+func (x *FloorSheetEntry) GetContractRate() float64 {
+	if x != nil {
+		return x.ContractRate
+	}
+	return 0
+}
+
+func (x *FloorSheetEntry) GetBusinessDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BusinessDate
+	}
+	return nil
+}
+
+func (x *FloorSheetEntry) GetTradeTime() string {
+	if x != nil {
+		return x.TradeTime
+	}
+	return ""
+}
+
+func (x *FloorSheetEntry) GetSecurityId() int32 {
+	if x != nil {
+		return x.SecurityId
+	}
+	return 0
+}
+
+func (x *FloorSheetEntry) GetContractAmount() float64 {
+	if x != nil {
+		return x.ContractAmount
+	}
+	return 0
+}
+
+func (x *FloorSheetEntry) GetBuyerBrokerName() string {
+	if x != nil {
+		return x.BuyerBrokerName
+	}
+	return ""
+}
+
+func (x *FloorSheetEntry) GetSellerBrokerName() string {
+	if x != nil {
+		return x.SellerBrokerName
+	}
+	return ""
+}
+
+func (x *FloorSheetEntry) GetTradeBookId() int64 {
+	if x != nil {
+		return x.TradeBookId
+	}
+	return 0
+}
+
+type TopListEntry struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Symbol              string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	SecurityName        string                 `protobuf:"bytes,2,opt,name=security_name,json=securityName,proto3" json:"security_name,omitempty"`
+	ClosePrice          float64                `protobuf:"fixed64,3,opt,name=close_price,json=closePrice,proto3" json:"close_price,omitempty"`
+	PercentageChange    *float64               `protobuf:"fixed64,4,opt,name=percentage_change,json=percentageChange,proto3,oneof" json:"percentage_change,omitempty"`
+	DifferenceRs        float64                `protobuf:"fixed64,5,opt,name=difference_rs,json=differenceRs,proto3" json:"difference_rs,omitempty"`
+	TotalTradedQuantity int64                  `protobuf:"varint,6,opt,name=total_traded_quantity,json=totalTradedQuantity,proto3" json:"total_traded_quantity,omitempty"`
+	TotalTradedValue    float64                `protobuf:"fixed64,7,opt,name=total_traded_value,json=totalTradedValue,proto3" json:"total_traded_value,omitempty"`
+	TotalTrades         int32                  `protobuf:"varint,8,opt,name=total_trades,json=totalTrades,proto3" json:"total_trades,omitempty"`
+	HighPrice           float64                `protobuf:"fixed64,9,opt,name=high_price,json=highPrice,proto3" json:"high_price,omitempty"`
+	LowPrice            float64                `protobuf:"fixed64,10,opt,name=low_price,json=lowPrice,proto3" json:"low_price,omitempty"`
+	OpenPrice           float64                `protobuf:"fixed64,11,opt,name=open_price,json=openPrice,proto3" json:"open_price,omitempty"`
+	PreviousClose       float64                `protobuf:"fixed64,12,opt,name=previous_close,json=previousClose,proto3" json:"previous_close,omitempty"`
+	SecurityId          int32                  `protobuf:"varint,13,opt,name=security_id,json=securityId,proto3" json:"security_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TopListEntry) Reset() {
+	*x = TopListEntry{}
+	mi := &file_nepse_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopListEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopListEntry) ProtoMessage() {}
+
+func (x *TopListEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopListEntry.ProtoReflect.Descriptor instead.
+func (*TopListEntry) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TopListEntry) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *TopListEntry) GetSecurityName() string {
+	if x != nil {
+		return x.SecurityName
+	}
+	return ""
+}
+
+func (x *TopListEntry) GetClosePrice() float64 {
+	if x != nil {
+		return x.ClosePrice
+	}
+	return 0
+}
+
+func (x *TopListEntry) GetPercentageChange() float64 {
+	if x != nil && x.PercentageChange != nil {
+		return *x.PercentageChange
+	}
+	return 0
+}
+
+func (x *TopListEntry) GetDifferenceRs() float64 {
+	if x != nil {
+		return x.DifferenceRs
+	}
+	return 0
+}
+
+func (x *TopListEntry) GetTotalTradedQuantity() int64 {
+	if x != nil {
+		return x.TotalTradedQuantity
+	}
+	return 0
+}
+
+func (x *TopListEntry) GetTotalTradedValue() float64 {
+	if x != nil {
+		return x.TotalTradedValue
+	}
+	return 0
+}
+
+func (x *TopListEntry) GetTotalTrades() int32 {
+	if x != nil {
+		return x.TotalTrades
+	}
+	return 0
+}
+
+func (x *TopListEntry) GetHighPrice() float64 {
+	if x != nil {
+		return x.HighPrice
+	}
+	return 0
+}
+
+func (x *TopListEntry) GetLowPrice() float64 {
+	if x != nil {
+		return x.LowPrice
+	}
+	return 0
+}
+
+func (x *TopListEntry) GetOpenPrice() float64 {
+	if x != nil {
+		return x.OpenPrice
+	}
+	return 0
+}
+
+func (x *TopListEntry) GetPreviousClose() float64 {
+	if x != nil {
+		return x.PreviousClose
+	}
+	return 0
+}
+
+func (x *TopListEntry) GetSecurityId() int32 {
+	if x != nil {
+		return x.SecurityId
+	}
+	return 0
+}
+
+type LiveMarketEntry struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Symbol           string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	SecurityName     string                 `protobuf:"bytes,2,opt,name=security_name,json=securityName,proto3" json:"security_name,omitempty"`
+	OpenPrice        float64                `protobuf:"fixed64,3,opt,name=open_price,json=openPrice,proto3" json:"open_price,omitempty"`
+	HighPrice        float64                `protobuf:"fixed64,4,opt,name=high_price,json=highPrice,proto3" json:"high_price,omitempty"`
+	LowPrice         float64                `protobuf:"fixed64,5,opt,name=low_price,json=lowPrice,proto3" json:"low_price,omitempty"`
+	ClosePrice       float64                `protobuf:"fixed64,6,opt,name=close_price,json=closePrice,proto3" json:"close_price,omitempty"`
+	PercentChange    *float64               `protobuf:"fixed64,7,opt,name=percent_change,json=percentChange,proto3,oneof" json:"percent_change,omitempty"`
+	Volume           int64                  `protobuf:"varint,8,opt,name=volume,proto3" json:"volume,omitempty"`
+	PreviousClose    float64                `protobuf:"fixed64,9,opt,name=previous_close,json=previousClose,proto3" json:"previous_close,omitempty"`
+	LastTradedVolume int64                  `protobuf:"varint,10,opt,name=last_traded_volume,json=lastTradedVolume,proto3" json:"last_traded_volume,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LiveMarketEntry) Reset() {
+	*x = LiveMarketEntry{}
+	mi := &file_nepse_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveMarketEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveMarketEntry) ProtoMessage() {}
+
+func (x *LiveMarketEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveMarketEntry.ProtoReflect.Descriptor instead.
+func (*LiveMarketEntry) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LiveMarketEntry) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *LiveMarketEntry) GetSecurityName() string {
+	if x != nil {
+		return x.SecurityName
+	}
+	return ""
+}
+
+func (x *LiveMarketEntry) GetOpenPrice() float64 {
+	if x != nil {
+		return x.OpenPrice
+	}
+	return 0
+}
+
+func (x *LiveMarketEntry) GetHighPrice() float64 {
+	if x != nil {
+		return x.HighPrice
+	}
+	return 0
+}
+
+func (x *LiveMarketEntry) GetLowPrice() float64 {
+	if x != nil {
+		return x.LowPrice
+	}
+	return 0
+}
+
+func (x *LiveMarketEntry) GetClosePrice() float64 {
+	if x != nil {
+		return x.ClosePrice
+	}
+	return 0
+}
+
+func (x *LiveMarketEntry) GetPercentChange() float64 {
+	if x != nil && x.PercentChange != nil {
+		return *x.PercentChange
+	}
+	return 0
+}
+
+func (x *LiveMarketEntry) GetVolume() int64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *LiveMarketEntry) GetPreviousClose() float64 {
+	if x != nil {
+		return x.PreviousClose
+	}
+	return 0
+}
+
+func (x *LiveMarketEntry) GetLastTradedVolume() int64 {
+	if x != nil {
+		return x.LastTradedVolume
+	}
+	return 0
+}
+
+type DepthLevel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Price         float64                `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Orders        int32                  `protobuf:"varint,3,opt,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepthLevel) Reset() {
+	*x = DepthLevel{}
+	mi := &file_nepse_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepthLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepthLevel) ProtoMessage() {}
+
+func (x *DepthLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepthLevel.ProtoReflect.Descriptor instead.
+func (*DepthLevel) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DepthLevel) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *DepthLevel) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *DepthLevel) GetOrders() int32 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+type MarketDepth struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SecurityId        int32                  `protobuf:"varint,1,opt,name=security_id,json=securityId,proto3" json:"security_id,omitempty"`
+	Symbol            string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	SecurityName      string                 `protobuf:"bytes,3,opt,name=security_name,json=securityName,proto3" json:"security_name,omitempty"`
+	BuyDepth          []*DepthLevel          `protobuf:"bytes,4,rep,name=buy_depth,json=buyDepth,proto3" json:"buy_depth,omitempty"`
+	SellDepth         []*DepthLevel          `protobuf:"bytes,5,rep,name=sell_depth,json=sellDepth,proto3" json:"sell_depth,omitempty"`
+	TotalBuyQuantity  int64                  `protobuf:"varint,6,opt,name=total_buy_quantity,json=totalBuyQuantity,proto3" json:"total_buy_quantity,omitempty"`
+	TotalSellQuantity int64                  `protobuf:"varint,7,opt,name=total_sell_quantity,json=totalSellQuantity,proto3" json:"total_sell_quantity,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MarketDepth) Reset() {
+	*x = MarketDepth{}
+	mi := &file_nepse_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketDepth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketDepth) ProtoMessage() {}
+
+func (x *MarketDepth) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketDepth.ProtoReflect.Descriptor instead.
+func (*MarketDepth) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MarketDepth) GetSecurityId() int32 {
+	if x != nil {
+		return x.SecurityId
+	}
+	return 0
+}
+
+func (x *MarketDepth) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *MarketDepth) GetSecurityName() string {
+	if x != nil {
+		return x.SecurityName
+	}
+	return ""
+}
+
+func (x *MarketDepth) GetBuyDepth() []*DepthLevel {
+	if x != nil {
+		return x.BuyDepth
+	}
+	return nil
+}
+
+func (x *MarketDepth) GetSellDepth() []*DepthLevel {
+	if x != nil {
+		return x.SellDepth
+	}
+	return nil
+}
+
+func (x *MarketDepth) GetTotalBuyQuantity() int64 {
+	if x != nil {
+		return x.TotalBuyQuantity
+	}
+	return 0
+}
+
+func (x *MarketDepth) GetTotalSellQuantity() int64 {
+	if x != nil {
+		return x.TotalSellQuantity
+	}
+	return 0
+}
+
+type NepseIndex struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	IndexValue       float64                `protobuf:"fixed64,1,opt,name=index_value,json=indexValue,proto3" json:"index_value,omitempty"`
+	PercentChange    float64                `protobuf:"fixed64,2,opt,name=percent_change,json=percentChange,proto3" json:"percent_change,omitempty"`
+	PointChange      float64                `protobuf:"fixed64,3,opt,name=point_change,json=pointChange,proto3" json:"point_change,omitempty"`
+	High             float64                `protobuf:"fixed64,4,opt,name=high,proto3" json:"high,omitempty"`
+	Low              float64                `protobuf:"fixed64,5,opt,name=low,proto3" json:"low,omitempty"`
+	PreviousClose    float64                `protobuf:"fixed64,6,opt,name=previous_close,json=previousClose,proto3" json:"previous_close,omitempty"`
+	FiftyTwoWeekHigh float64                `protobuf:"fixed64,7,opt,name=fifty_two_week_high,json=fiftyTwoWeekHigh,proto3" json:"fifty_two_week_high,omitempty"`
+	FiftyTwoWeekLow  float64                `protobuf:"fixed64,8,opt,name=fifty_two_week_low,json=fiftyTwoWeekLow,proto3" json:"fifty_two_week_low,omitempty"`
+	CurrentValue     float64                `protobuf:"fixed64,9,opt,name=current_value,json=currentValue,proto3" json:"current_value,omitempty"`
+	GeneratedTime    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=generated_time,json=generatedTime,proto3" json:"generated_time,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *NepseIndex) Reset() {
+	*x = NepseIndex{}
+	mi := &file_nepse_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NepseIndex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NepseIndex) ProtoMessage() {}
+
+func (x *NepseIndex) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NepseIndex.ProtoReflect.Descriptor instead.
+func (*NepseIndex) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *NepseIndex) GetIndexValue() float64 {
+	if x != nil {
+		return x.IndexValue
+	}
+	return 0
+}
+
+func (x *NepseIndex) GetPercentChange() float64 {
+	if x != nil {
+		return x.PercentChange
+	}
+	return 0
+}
+
+func (x *NepseIndex) GetPointChange() float64 {
+	if x != nil {
+		return x.PointChange
+	}
+	return 0
+}
+
+func (x *NepseIndex) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *NepseIndex) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *NepseIndex) GetPreviousClose() float64 {
+	if x != nil {
+		return x.PreviousClose
+	}
+	return 0
+}
+
+func (x *NepseIndex) GetFiftyTwoWeekHigh() float64 {
+	if x != nil {
+		return x.FiftyTwoWeekHigh
+	}
+	return 0
+}
+
+func (x *NepseIndex) GetFiftyTwoWeekLow() float64 {
+	if x != nil {
+		return x.FiftyTwoWeekLow
+	}
+	return 0
+}
+
+func (x *NepseIndex) GetCurrentValue() float64 {
+	if x != nil {
+		return x.CurrentValue
+	}
+	return 0
+}
+
+func (x *NepseIndex) GetGeneratedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedTime
+	}
+	return nil
+}
+
+type MarketStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "OPEN" or "CLOSE"
+	IsOpen        string                 `protobuf:"bytes,1,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	Id            int32                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketStatus) Reset() {
+	*x = MarketStatus{}
+	mi := &file_nepse_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketStatus) ProtoMessage() {}
+
+func (x *MarketStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketStatus.ProtoReflect.Descriptor instead.
+func (*MarketStatus) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MarketStatus) GetIsOpen() string {
+	if x != nil {
+		return x.IsOpen
+	}
+	return ""
+}
+
+func (x *MarketStatus) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *MarketStatus) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PriceBand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecurityId    int32                  `protobuf:"varint,1,opt,name=security_id,json=securityId,proto3" json:"security_id,omitempty"`
+	Symbol        string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	PreviousClose float64                `protobuf:"fixed64,3,opt,name=previous_close,json=previousClose,proto3" json:"previous_close,omitempty"`
+	Percent       float64                `protobuf:"fixed64,4,opt,name=percent,proto3" json:"percent,omitempty"`
+	TickSize      float64                `protobuf:"fixed64,5,opt,name=tick_size,json=tickSize,proto3" json:"tick_size,omitempty"`
+	Upper         float64                `protobuf:"fixed64,6,opt,name=upper,proto3" json:"upper,omitempty"`
+	Lower         float64                `protobuf:"fixed64,7,opt,name=lower,proto3" json:"lower,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceBand) Reset() {
+	*x = PriceBand{}
+	mi := &file_nepse_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceBand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceBand) ProtoMessage() {}
+
+func (x *PriceBand) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceBand.ProtoReflect.Descriptor instead.
+func (*PriceBand) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PriceBand) GetSecurityId() int32 {
+	if x != nil {
+		return x.SecurityId
+	}
+	return 0
+}
+
+func (x *PriceBand) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *PriceBand) GetPreviousClose() float64 {
+	if x != nil {
+		return x.PreviousClose
+	}
+	return 0
+}
+
+func (x *PriceBand) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *PriceBand) GetTickSize() float64 {
+	if x != nil {
+		return x.TickSize
+	}
+	return 0
+}
+
+func (x *PriceBand) GetUpper() float64 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
+func (x *PriceBand) GetLower() float64 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+type MarketOpened struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Status        *MarketStatus          `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketOpened) Reset() {
+	*x = MarketOpened{}
+	mi := &file_nepse_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketOpened) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketOpened) ProtoMessage() {}
+
+func (x *MarketOpened) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketOpened.ProtoReflect.Descriptor instead.
+func (*MarketOpened) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MarketOpened) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *MarketOpened) GetStatus() *MarketStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type MarketClosed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Status        *MarketStatus          `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketClosed) Reset() {
+	*x = MarketClosed{}
+	mi := &file_nepse_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketClosed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketClosed) ProtoMessage() {}
+
+func (x *MarketClosed) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketClosed.ProtoReflect.Descriptor instead.
+func (*MarketClosed) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MarketClosed) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *MarketClosed) GetStatus() *MarketStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type PriceTick struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Quote         *Quote                 `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceTick) Reset() {
+	*x = PriceTick{}
+	mi := &file_nepse_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceTick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceTick) ProtoMessage() {}
+
+func (x *PriceTick) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceTick.ProtoReflect.Descriptor instead.
+func (*PriceTick) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PriceTick) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *PriceTick) GetQuote() *Quote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+type IndexTick struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Time  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// "NEPSE", "Sensitive", "Float" or "Sensitive Float"
+	Name          string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Index         *NepseIndex `protobuf:"bytes,3,opt,name=index,proto3" json:"index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IndexTick) Reset() {
+	*x = IndexTick{}
+	mi := &file_nepse_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IndexTick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexTick) ProtoMessage() {}
+
+func (x *IndexTick) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexTick.ProtoReflect.Descriptor instead.
+func (*IndexTick) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *IndexTick) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *IndexTick) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IndexTick) GetIndex() *NepseIndex {
+	if x != nil {
+		return x.Index
+	}
+	return nil
+}
+
+type VWAPTick struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Symbol        string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Vwap          float64                `protobuf:"fixed64,3,opt,name=vwap,proto3" json:"vwap,omitempty"`
+	Volume        int64                  `protobuf:"varint,4,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VWAPTick) Reset() {
+	*x = VWAPTick{}
+	mi := &file_nepse_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VWAPTick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VWAPTick) ProtoMessage() {}
+
+func (x *VWAPTick) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VWAPTick.ProtoReflect.Descriptor instead.
+func (*VWAPTick) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *VWAPTick) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *VWAPTick) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *VWAPTick) GetVwap() float64 {
+	if x != nil {
+		return x.Vwap
+	}
+	return 0
+}
+
+func (x *VWAPTick) GetVolume() int64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+type CircuitBreakerHit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Time  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Quote *Quote                 `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	Band  *PriceBand             `protobuf:"bytes,3,opt,name=band,proto3" json:"band,omitempty"`
+	// True for the upper circuit, false for the lower one
+	Upper         bool `protobuf:"varint,4,opt,name=upper,proto3" json:"upper,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CircuitBreakerHit) Reset() {
+	*x = CircuitBreakerHit{}
+	mi := &file_nepse_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CircuitBreakerHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitBreakerHit) ProtoMessage() {}
+
+func (x *CircuitBreakerHit) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitBreakerHit.ProtoReflect.Descriptor instead.
+func (*CircuitBreakerHit) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CircuitBreakerHit) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *CircuitBreakerHit) GetQuote() *Quote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+func (x *CircuitBreakerHit) GetBand() *PriceBand {
+	if x != nil {
+		return x.Band
+	}
+	return nil
+}
+
+func (x *CircuitBreakerHit) GetUpper() bool {
+	if x != nil {
+		return x.Upper
+	}
+	return false
+}
+
+// MarketEvent carries any one event, for streams mixing event types
+type MarketEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*MarketEvent_MarketOpened
+	//	*MarketEvent_MarketClosed
+	//	*MarketEvent_PriceTick
+	//	*MarketEvent_IndexTick
+	//	*MarketEvent_VwapTick
+	//	*MarketEvent_CircuitBreakerHit
+	Event         isMarketEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketEvent) Reset() {
+	*x = MarketEvent{}
+	mi := &file_nepse_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketEvent) ProtoMessage() {}
+
+func (x *MarketEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketEvent.ProtoReflect.Descriptor instead.
+func (*MarketEvent) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MarketEvent) GetEvent() isMarketEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *MarketEvent) GetMarketOpened() *MarketOpened {
+	if x != nil {
+		if x, ok := x.Event.(*MarketEvent_MarketOpened); ok {
+			return x.MarketOpened
+		}
+	}
+	return nil
+}
+
+func (x *MarketEvent) GetMarketClosed() *MarketClosed {
+	if x != nil {
+		if x, ok := x.Event.(*MarketEvent_MarketClosed); ok {
+			return x.MarketClosed
+		}
+	}
+	return nil
+}
+
+func (x *MarketEvent) GetPriceTick() *PriceTick {
+	if x != nil {
+		if x, ok := x.Event.(*MarketEvent_PriceTick); ok {
+			return x.PriceTick
+		}
+	}
+	return nil
+}
+
+func (x *MarketEvent) GetIndexTick() *IndexTick {
+	if x != nil {
+		if x, ok := x.Event.(*MarketEvent_IndexTick); ok {
+			return x.IndexTick
+		}
+	}
+	return nil
+}
+
+func (x *MarketEvent) GetVwapTick() *VWAPTick {
+	if x != nil {
+		if x, ok := x.Event.(*MarketEvent_VwapTick); ok {
+			return x.VwapTick
+		}
+	}
+	return nil
+}
+
+func (x *MarketEvent) GetCircuitBreakerHit() *CircuitBreakerHit {
+	if x != nil {
+		if x, ok := x.Event.(*MarketEvent_CircuitBreakerHit); ok {
+			return x.CircuitBreakerHit
+		}
+	}
+	return nil
+}
+
+type isMarketEvent_Event interface {
+	isMarketEvent_Event()
+}
+
+type MarketEvent_MarketOpened struct {
+	MarketOpened *MarketOpened `protobuf:"bytes,1,opt,name=market_opened,json=marketOpened,proto3,oneof"`
+}
+
+type MarketEvent_MarketClosed struct {
+	MarketClosed *MarketClosed `protobuf:"bytes,2,opt,name=market_closed,json=marketClosed,proto3,oneof"`
+}
+
+type MarketEvent_PriceTick struct {
+	PriceTick *PriceTick `protobuf:"bytes,3,opt,name=price_tick,json=priceTick,proto3,oneof"`
+}
+
+type MarketEvent_IndexTick struct {
+	IndexTick *IndexTick `protobuf:"bytes,4,opt,name=index_tick,json=indexTick,proto3,oneof"`
+}
+
+type MarketEvent_VwapTick struct {
+	VwapTick *VWAPTick `protobuf:"bytes,5,opt,name=vwap_tick,json=vwapTick,proto3,oneof"`
+}
+
+type MarketEvent_CircuitBreakerHit struct {
+	CircuitBreakerHit *CircuitBreakerHit `protobuf:"bytes,6,opt,name=circuit_breaker_hit,json=circuitBreakerHit,proto3,oneof"`
+}
+
+func (*MarketEvent_MarketOpened) isMarketEvent_Event() {}
+
+func (*MarketEvent_MarketClosed) isMarketEvent_Event() {}
+
+func (*MarketEvent_PriceTick) isMarketEvent_Event() {}
+
+func (*MarketEvent_IndexTick) isMarketEvent_Event() {}
+
+func (*MarketEvent_VwapTick) isMarketEvent_Event() {}
+
+func (*MarketEvent_CircuitBreakerHit) isMarketEvent_Event() {}
+
+type GetQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuoteRequest) Reset() {
+	*x = GetQuoteRequest{}
+	mi := &file_nepse_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuoteRequest) ProtoMessage() {}
+
+func (x *GetQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetQuoteRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type StreamQuotesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Symbols []string               `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	// Polling interval, 5 seconds if unset
+	Interval      *durationpb.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamQuotesRequest) Reset() {
+	*x = StreamQuotesRequest{}
+	mi := &file_nepse_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamQuotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamQuotesRequest) ProtoMessage() {}
+
+func (x *StreamQuotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamQuotesRequest.ProtoReflect.Descriptor instead.
+func (*StreamQuotesRequest) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *StreamQuotesRequest) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *StreamQuotesRequest) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+type GetFloorSheetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Trades of one security; all trades if empty, which can exceed the default
+	// 4 MB message limit of gRPC clients on busy days
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// YYYY-MM-DD; the latest session if empty
+	BusinessDate  string `protobuf:"bytes,2,opt,name=business_date,json=businessDate,proto3" json:"business_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFloorSheetRequest) Reset() {
+	*x = GetFloorSheetRequest{}
+	mi := &file_nepse_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFloorSheetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFloorSheetRequest) ProtoMessage() {}
+
+func (x *GetFloorSheetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFloorSheetRequest.ProtoReflect.Descriptor instead.
+func (*GetFloorSheetRequest) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetFloorSheetRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GetFloorSheetRequest) GetBusinessDate() string {
+	if x != nil {
+		return x.BusinessDate
+	}
+	return ""
+}
+
+type FloorSheet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*FloorSheetEntry     `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FloorSheet) Reset() {
+	*x = FloorSheet{}
+	mi := &file_nepse_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FloorSheet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloorSheet) ProtoMessage() {}
+
+func (x *FloorSheet) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloorSheet.ProtoReflect.Descriptor instead.
+func (*FloorSheet) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FloorSheet) GetEntries() []*FloorSheetEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type GetHistoryRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Symbol string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// YYYY-MM-DD
+	StartDate     string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoryRequest) Reset() {
+	*x = GetHistoryRequest{}
+	mi := &file_nepse_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryRequest) ProtoMessage() {}
+
+func (x *GetHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetHistoryRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GetHistoryRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetHistoryRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type PriceHistoryList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*PriceHistory        `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceHistoryList) Reset() {
+	*x = PriceHistoryList{}
+	mi := &file_nepse_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceHistoryList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceHistoryList) ProtoMessage() {}
+
+func (x *PriceHistoryList) ProtoReflect() protoreflect.Message {
+	mi := &file_nepse_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceHistoryList.ProtoReflect.Descriptor instead.
+func (*PriceHistoryList) Descriptor() ([]byte, []int) {
+	return file_nepse_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PriceHistoryList) GetEntries() []*PriceHistory {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+var File_nepse_proto protoreflect.FileDescriptor
+
+const file_nepse_proto_rawDesc = "" +
+	"\n" +
+	"\vnepse.proto\x12\bnepse.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc6\x03\n" +
+	"\x05Quote\x12\x1f\n" +
+	"\vsecurity_id\x18\x01 \x01(\x05R\n" +
+	"securityId\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12#\n" +
+	"\rsecurity_name\x18\x03 \x01(\tR\fsecurityName\x12\x12\n" +
+	"\x04open\x18\x04 \x01(\x01R\x04open\x12\x12\n" +
+	"\x04high\x18\x05 \x01(\x01R\x04high\x12\x10\n" +
+	"\x03low\x18\x06 \x01(\x01R\x03low\x12*\n" +
+	"\x11last_traded_price\x18\a \x01(\x01R\x0flastTradedPrice\x12%\n" +
+	"\x0eprevious_close\x18\b \x01(\x01R\rpreviousClose\x12\x16\n" +
+	"\x06change\x18\t \x01(\x01R\x06change\x12%\n" +
+	"\x0epercent_change\x18\n" +
+	" \x01(\x01R\rpercentChange\x12\x16\n" +
+	"\x06volume\x18\v \x01(\x03R\x06volume\x12\x1a\n" +
+	"\bturnover\x18\f \x01(\x01R\bturnover\x12\x16\n" +
+	"\x06trades\x18\r \x01(\x05R\x06trades\x12/\n" +
+	"\x05as_of\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12\x16\n" +
+	"\x06source\x18\x0f \x01(\tR\x06source\"\xba\x02\n" +
+	"\rMarketSummary\x12%\n" +
+	"\x0etotal_turnover\x18\x01 \x01(\x01R\rtotalTurnover\x12.\n" +
+	"\x13total_traded_shares\x18\x02 \x01(\x01R\x11totalTradedShares\x12-\n" +
+	"\x12total_transactions\x18\x03 \x01(\x01R\x11totalTransactions\x12.\n" +
+	"\x13total_scrips_traded\x18\x04 \x01(\x01R\x11totalScripsTraded\x12>\n" +
+	"\x1btotal_market_capitalization\x18\x05 \x01(\x01R\x19totalMarketCapitalization\x123\n" +
+	"\x16total_float_market_cap\x18\x06 \x01(\x01R\x13totalFloatMarketCap\"\xb5\x03\n" +
+	"\bSecurity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12#\n" +
+	"\rsecurity_name\x18\x03 \x01(\tR\fsecurityName\x12!\n" +
+	"\fis_suspended\x18\x04 \x01(\bR\visSuspended\x12\x1f\n" +
+	"\vsector_name\x18\x05 \x01(\tR\n" +
+	"sectorName\x12\x1e\n" +
+	"\n" +
+	"instrument\x18\x06 \x01(\tR\n" +
+	"instrument\x124\n" +
+	"\x16regulatory_category_id\x18\a \x01(\x05R\x14regulatoryCategoryId\x12$\n" +
+	"\x0eshare_group_id\x18\b \x01(\x05R\fshareGroupId\x12#\n" +
+	"\ractive_status\x18\t \x01(\tR\factiveStatus\x12!\n" +
+	"\flisting_date\x18\n" +
+	" \x01(\tR\vlistingDate\x12+\n" +
+	"\x11suspension_reason\x18\v \x01(\tR\x10suspensionReason\x12'\n" +
+	"\x0fsuspended_since\x18\f \x01(\tR\x0esuspendedSince\"\xa8\b\n" +
+	"\n" +
+	"TodayPrice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12#\n" +
+	"\rsecurity_name\x18\x03 \x01(\tR\fsecurityName\x12\x1d\n" +
+	"\n" +
+	"open_price\x18\x04 \x01(\x01R\topenPrice\x12\x1d\n" +
+	"\n" +
+	"high_price\x18\x05 \x01(\x01R\thighPrice\x12\x1b\n" +
+	"\tlow_price\x18\x06 \x01(\x01R\blowPrice\x12\x1f\n" +
+	"\vclose_price\x18\a \x01(\x01R\n" +
+	"closePrice\x122\n" +
+	"\x15total_traded_quantity\x18\b \x01(\x03R\x13totalTradedQuantity\x12,\n" +
+	"\x12total_traded_value\x18\t \x01(\x01R\x10totalTradedValue\x12%\n" +
+	"\x0eprevious_close\x18\n" +
+	" \x01(\x01R\rpreviousClose\x12#\n" +
+	"\rdifference_rs\x18\v \x01(\x01R\fdifferenceRs\x120\n" +
+	"\x11percentage_change\x18\f \x01(\x01H\x00R\x10percentageChange\x88\x01\x01\x12!\n" +
+	"\ftotal_trades\x18\r \x01(\x05R\vtotalTrades\x12?\n" +
+	"\rbusiness_date\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\fbusinessDate\x12\x1f\n" +
+	"\vsecurity_id\x18\x0f \x01(\x05R\n" +
+	"securityId\x12*\n" +
+	"\x11last_traded_price\x18\x10 \x01(\x01R\x0flastTradedPrice\x12\x1b\n" +
+	"\tmax_price\x18\x11 \x01(\x01R\bmaxPrice\x12\x1b\n" +
+	"\tmin_price\x18\x12 \x01(\x01R\bminPrice\x120\n" +
+	"\x14average_traded_price\x18\x13 \x01(\x01R\x12averageTradedPrice\x123\n" +
+	"\x15market_capitalization\x18\x14 \x01(\x01R\x14marketCapitalization\x12-\n" +
+	"\x13fifty_two_week_high\x18\x15 \x01(\x01R\x10fiftyTwoWeekHigh\x12+\n" +
+	"\x12fifty_two_week_low\x18\x16 \x01(\x01R\x0ffiftyTwoWeekLow\x12,\n" +
+	"\x12last_updated_price\x18\x17 \x01(\x01R\x10lastUpdatedPrice\x12F\n" +
+	"\x11last_updated_time\x18\x18 \x01(\v2\x1a.google.protobuf.TimestampR\x0flastUpdatedTime\x127\n" +
+	"\x18previous_day_close_price\x18\x19 \x01(\x01R\x15previousDayClosePriceB\x14\n" +
+	"\x12_percentage_change\"\xc2\x04\n" +
+	"\fPriceHistory\x12?\n" +
+	"\rbusiness_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\fbusinessDate\x12\x1f\n" +
+	"\vsecurity_id\x18\x02 \x01(\x05R\n" +
+	"securityId\x12\x16\n" +
+	"\x06symbol\x18\x03 \x01(\tR\x06symbol\x12#\n" +
+	"\rsecurity_name\x18\x04 \x01(\tR\fsecurityName\x12\x1d\n" +
+	"\n" +
+	"open_price\x18\x05 \x01(\x01R\topenPrice\x12\x1d\n" +
+	"\n" +
+	"high_price\x18\x06 \x01(\x01R\thighPrice\x12\x1b\n" +
+	"\tlow_price\x18\a \x01(\x01R\blowPrice\x12\x1f\n" +
+	"\vclose_price\x18\b \x01(\x01R\n" +
+	"closePrice\x122\n" +
+	"\x15total_traded_quantity\x18\t \x01(\x03R\x13totalTradedQuantity\x12,\n" +
+	"\x12total_traded_value\x18\n" +
+	" \x01(\x01R\x10totalTradedValue\x12!\n" +
+	"\ftotal_trades\x18\v \x01(\x05R\vtotalTrades\x12%\n" +
+	"\x0eprevious_close\x18\f \x01(\x01R\rpreviousClose\x12#\n" +
+	"\rdifference_rs\x18\r \x01(\x01R\fdifferenceRs\x120\n" +
+	"\x11percentage_change\x18\x0e \x01(\x01H\x00R\x10percentageChange\x88\x01\x01B\x14\n" +
+	"\x12_percentage_change\"\xc6\x04\n" +
+	"\x0fFloorSheetEntry\x12\x1f\n" +
+	"\vcontract_id\x18\x01 \x01(\x03R\n" +
+	"contractId\x12!\n" +
+	"\fstock_symbol\x18\x02 \x01(\tR\vstockSymbol\x12#\n" +
+	"\rsecurity_name\x18\x03 \x01(\tR\fsecurityName\x12&\n" +
+	"\x0fbuyer_member_id\x18\x04 \x01(\x05R\rbuyerMemberId\x12(\n" +
+	"\x10seller_member_id\x18\x05 \x01(\x05R\x0esellerMemberId\x12+\n" +
+	"\x11contract_quantity\x18\x06 \x01(\x03R\x10contractQuantity\x12#\n" +
+	"\rcontract_rate\x18\a \x01(\x01R\fcontractRate\x12?\n" +
+	"\rbusiness_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\fbusinessDate\x12\x1d\n" +
+	"\n" +
+	"trade_time\x18\t \x01(\tR\ttradeTime\x12\x1f\n" +
+	"\vsecurity_id\x18\n" +
+	" \x01(\x05R\n" +
+	"securityId\x12'\n" +
+	"\x0fcontract_amount\x18\v \x01(\x01R\x0econtractAmount\x12*\n" +
+	"\x11buyer_broker_name\x18\f \x01(\tR\x0fbuyerBrokerName\x12,\n" +
+	"\x12seller_broker_name\x18\r \x01(\tR\x10sellerBrokerName\x12\"\n" +
+	"\rtrade_book_id\x18\x0e \x01(\x03R\vtradeBookId\"\x81\x04\n" +
+	"\fTopListEntry\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12#\n" +
+	"\rsecurity_name\x18\x02 \x01(\tR\fsecurityName\x12\x1f\n" +
+	"\vclose_price\x18\x03 \x01(\x01R\n" +
+	"closePrice\x120\n" +
+	"\x11percentage_change\x18\x04 \x01(\x01H\x00R\x10percentageChange\x88\x01\x01\x12#\n" +
+	"\rdifference_rs\x18\x05 \x01(\x01R\fdifferenceRs\x122\n" +
+	"\x15total_traded_quantity\x18\x06 \x01(\x03R\x13totalTradedQuantity\x12,\n" +
+	"\x12total_traded_value\x18\a \x01(\x01R\x10totalTradedValue\x12!\n" +
+	"\ftotal_trades\x18\b \x01(\x05R\vtotalTrades\x12\x1d\n" +
+	"\n" +
+	"high_price\x18\t \x01(\x01R\thighPrice\x12\x1b\n" +
+	"\tlow_price\x18\n" +
+	" \x01(\x01R\blowPrice\x12\x1d\n" +
+	"\n" +
+	"open_price\x18\v \x01(\x01R\topenPrice\x12%\n" +
+	"\x0eprevious_close\x18\f \x01(\x01R\rpreviousClose\x12\x1f\n" +
+	"\vsecurity_id\x18\r \x01(\x05R\n" +
+	"securityIdB\x14\n" +
+	"\x12_percentage_change\"\xf6\x02\n" +
+	"\x0fLiveMarketEntry\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12#\n" +
+	"\rsecurity_name\x18\x02 \x01(\tR\fsecurityName\x12\x1d\n" +
+	"\n" +
+	"open_price\x18\x03 \x01(\x01R\topenPrice\x12\x1d\n" +
+	"\n" +
+	"high_price\x18\x04 \x01(\x01R\thighPrice\x12\x1b\n" +
+	"\tlow_price\x18\x05 \x01(\x01R\blowPrice\x12\x1f\n" +
+	"\vclose_price\x18\x06 \x01(\x01R\n" +
+	"closePrice\x12*\n" +
+	"\x0epercent_change\x18\a \x01(\x01H\x00R\rpercentChange\x88\x01\x01\x12\x16\n" +
+	"\x06volume\x18\b \x01(\x03R\x06volume\x12%\n" +
+	"\x0eprevious_close\x18\t \x01(\x01R\rpreviousClose\x12,\n" +
+	"\x12last_traded_volume\x18\n" +
+	" \x01(\x03R\x10lastTradedVolumeB\x11\n" +
+	"\x0f_percent_change\"V\n" +
+	"\n" +
+	"DepthLevel\x12\x14\n" +
+	"\x05price\x18\x01 \x01(\x01R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\x16\n" +
+	"\x06orders\x18\x03 \x01(\x05R\x06orders\"\xb1\x02\n" +
+	"\vMarketDepth\x12\x1f\n" +
+	"\vsecurity_id\x18\x01 \x01(\x05R\n" +
+	"securityId\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12#\n" +
+	"\rsecurity_name\x18\x03 \x01(\tR\fsecurityName\x121\n" +
+	"\tbuy_depth\x18\x04 \x03(\v2\x14.nepse.v1.DepthLevelR\bbuyDepth\x123\n" +
+	"\n" +
+	"sell_depth\x18\x05 \x03(\v2\x14.nepse.v1.DepthLevelR\tsellDepth\x12,\n" +
+	"\x12total_buy_quantity\x18\x06 \x01(\x03R\x10totalBuyQuantity\x12.\n" +
+	"\x13total_sell_quantity\x18\a \x01(\x03R\x11totalSellQuantity\"\x88\x03\n" +
+	"\n" +
+	"NepseIndex\x12\x1f\n" +
+	"\vindex_value\x18\x01 \x01(\x01R\n" +
+	"indexValue\x12%\n" +
+	"\x0epercent_change\x18\x02 \x01(\x01R\rpercentChange\x12!\n" +
+	"\fpoint_change\x18\x03 \x01(\x01R\vpointChange\x12\x12\n" +
+	"\x04high\x18\x04 \x01(\x01R\x04high\x12\x10\n" +
+	"\x03low\x18\x05 \x01(\x01R\x03low\x12%\n" +
+	"\x0eprevious_close\x18\x06 \x01(\x01R\rpreviousClose\x12-\n" +
+	"\x13fifty_two_week_high\x18\a \x01(\x01R\x10fiftyTwoWeekHigh\x12+\n" +
+	"\x12fifty_two_week_low\x18\b \x01(\x01R\x0ffiftyTwoWeekLow\x12#\n" +
+	"\rcurrent_value\x18\t \x01(\x01R\fcurrentValue\x12A\n" +
+	"\x0egenerated_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\rgeneratedTime\"h\n" +
+	"\fMarketStatus\x12\x17\n" +
+	"\ais_open\x18\x01 \x01(\tR\x06isOpen\x12/\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x05R\x02id\"\xce\x01\n" +
+	"\tPriceBand\x12\x1f\n" +
+	"\vsecurity_id\x18\x01 \x01(\x05R\n" +
+	"securityId\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12%\n" +
+	"\x0eprevious_close\x18\x03 \x01(\x01R\rpreviousClose\x12\x18\n" +
+	"\apercent\x18\x04 \x01(\x01R\apercent\x12\x1b\n" +
+	"\ttick_size\x18\x05 \x01(\x01R\btickSize\x12\x14\n" +
+	"\x05upper\x18\x06 \x01(\x01R\x05upper\x12\x14\n" +
+	"\x05lower\x18\a \x01(\x01R\x05lower\"n\n" +
+	"\fMarketOpened\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12.\n" +
+	"\x06status\x18\x02 \x01(\v2\x16.nepse.v1.MarketStatusR\x06status\"n\n" +
+	"\fMarketClosed\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12.\n" +
+	"\x06status\x18\x02 \x01(\v2\x16.nepse.v1.MarketStatusR\x06status\"b\n" +
+	"\tPriceTick\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12%\n" +
+	"\x05quote\x18\x02 \x01(\v2\x0f.nepse.v1.QuoteR\x05quote\"{\n" +
+	"\tIndexTick\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12*\n" +
+	"\x05index\x18\x03 \x01(\v2\x14.nepse.v1.NepseIndexR\x05index\"~\n" +
+	"\bVWAPTick\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x12\n" +
+	"\x04vwap\x18\x03 \x01(\x01R\x04vwap\x12\x16\n" +
+	"\x06volume\x18\x04 \x01(\x03R\x06volume\"\xa9\x01\n" +
+	"\x11CircuitBreakerHit\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12%\n" +
+	"\x05quote\x18\x02 \x01(\v2\x0f.nepse.v1.QuoteR\x05quote\x12'\n" +
+	"\x04band\x18\x03 \x01(\v2\x13.nepse.v1.PriceBandR\x04band\x12\x14\n" +
+	"\x05upper\x18\x04 \x01(\bR\x05upper\"\x82\x03\n" +
+	"\vMarketEvent\x12=\n" +
+	"\rmarket_opened\x18\x01 \x01(\v2\x16.nepse.v1.MarketOpenedH\x00R\fmarketOpened\x12=\n" +
+	"\rmarket_closed\x18\x02 \x01(\v2\x16.nepse.v1.MarketClosedH\x00R\fmarketClosed\x124\n" +
+	"\n" +
+	"price_tick\x18\x03 \x01(\v2\x13.nepse.v1.PriceTickH\x00R\tpriceTick\x124\n" +
+	"\n" +
+	"index_tick\x18\x04 \x01(\v2\x13.nepse.v1.IndexTickH\x00R\tindexTick\x121\n" +
+	"\tvwap_tick\x18\x05 \x01(\v2\x12.nepse.v1.VWAPTickH\x00R\bvwapTick\x12M\n" +
+	"\x13circuit_breaker_hit\x18\x06 \x01(\v2\x1b.nepse.v1.CircuitBreakerHitH\x00R\x11circuitBreakerHitB\a\n" +
+	"\x05event\")\n" +
+	"\x0fGetQuoteRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\"f\n" +
+	"\x13StreamQuotesRequest\x12\x18\n" +
+	"\asymbols\x18\x01 \x03(\tR\asymbols\x125\n" +
+	"\binterval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\binterval\"S\n" +
+	"\x14GetFloorSheetRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12#\n" +
+	"\rbusiness_date\x18\x02 \x01(\tR\fbusinessDate\"A\n" +
+	"\n" +
+	"FloorSheet\x123\n" +
+	"\aentries\x18\x01 \x03(\v2\x19.nepse.v1.FloorSheetEntryR\aentries\"e\n" +
+	"\x11GetHistoryRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\"D\n" +
+	"\x10PriceHistoryList\x120\n" +
+	"\aentries\x18\x01 \x03(\v2\x16.nepse.v1.PriceHistoryR\aentries2\x96\x02\n" +
+	"\fNepseService\x126\n" +
+	"\bGetQuote\x12\x19.nepse.v1.GetQuoteRequest\x1a\x0f.nepse.v1.Quote\x12@\n" +
+	"\fStreamQuotes\x12\x1d.nepse.v1.StreamQuotesRequest\x1a\x0f.nepse.v1.Quote0\x01\x12E\n" +
+	"\rGetFloorSheet\x12\x1e.nepse.v1.GetFloorSheetRequest\x1a\x14.nepse.v1.FloorSheet\x12E\n" +
+	"\n" +
+	"GetHistory\x12\x1b.nepse.v1.GetHistoryRequest\x1a\x1a.nepse.v1.PriceHistoryListB*Z(github.com/voidarchive/nepseauth/nepsepbb\x06proto3"
+
+var (
+	file_nepse_proto_rawDescOnce sync.Once
+	file_nepse_proto_rawDescData []byte
+)
+
+func file_nepse_proto_rawDescGZIP() []byte {
+	file_nepse_proto_rawDescOnce.Do(func() {
+		file_nepse_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_nepse_proto_rawDesc), len(file_nepse_proto_rawDesc)))
+	})
+	return file_nepse_proto_rawDescData
+}
+
+var file_nepse_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_nepse_proto_goTypes = []any{
+	(*Quote)(nil),                 // 0: nepse.v1.Quote
+	(*MarketSummary)(nil),         // 1: nepse.v1.MarketSummary
+	(*Security)(nil),              // 2: nepse.v1.Security
+	(*TodayPrice)(nil),            // 3: nepse.v1.TodayPrice
+	(*PriceHistory)(nil),          // 4: nepse.v1.PriceHistory
+	(*FloorSheetEntry)(nil),       // 5: nepse.v1.FloorSheetEntry
+	(*TopListEntry)(nil),          // 6: nepse.v1.TopListEntry
+	(*LiveMarketEntry)(nil),       // 7: nepse.v1.LiveMarketEntry
+	(*DepthLevel)(nil),            // 8: nepse.v1.DepthLevel
+	(*MarketDepth)(nil),           // 9: nepse.v1.MarketDepth
+	(*NepseIndex)(nil),            // 10: nepse.v1.NepseIndex
+	(*MarketStatus)(nil),          // 11: nepse.v1.MarketStatus
+	(*PriceBand)(nil),             // 12: nepse.v1.PriceBand
+	(*MarketOpened)(nil),          // 13: nepse.v1.MarketOpened
+	(*MarketClosed)(nil),          // 14: nepse.v1.MarketClosed
+	(*PriceTick)(nil),             // 15: nepse.v1.PriceTick
+	(*IndexTick)(nil),             // 16: nepse.v1.IndexTick
+	(*VWAPTick)(nil),              // 17: nepse.v1.VWAPTick
+	(*CircuitBreakerHit)(nil),     // 18: nepse.v1.CircuitBreakerHit
+	(*MarketEvent)(nil),           // 19: nepse.v1.MarketEvent
+	(*GetQuoteRequest)(nil),       // 20: nepse.v1.GetQuoteRequest
+	(*StreamQuotesRequest)(nil),   // 21: nepse.v1.StreamQuotesRequest
+	(*GetFloorSheetRequest)(nil),  // 22: nepse.v1.GetFloorSheetRequest
+	(*FloorSheet)(nil),            // 23: nepse.v1.FloorSheet
+	(*GetHistoryRequest)(nil),     // 24: nepse.v1.GetHistoryRequest
+	(*PriceHistoryList)(nil),      // 25: nepse.v1.PriceHistoryList
+	(*timestamppb.Timestamp)(nil), // 26: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 27: google.protobuf.Duration
+}
+var file_nepse_proto_depIdxs = []int32{
+	26, // 0: nepse.v1.Quote.as_of:type_name -> google.protobuf.Timestamp
+	26, // 1: nepse.v1.TodayPrice.business_date:type_name -> google.protobuf.Timestamp
+	26, // 2: nepse.v1.TodayPrice.last_updated_time:type_name -> google.protobuf.Timestamp
+	26, // 3: nepse.v1.PriceHistory.business_date:type_name -> google.protobuf.Timestamp
+	26, // 4: nepse.v1.FloorSheetEntry.business_date:type_name -> google.protobuf.Timestamp
+	8,  // 5: nepse.v1.MarketDepth.buy_depth:type_name -> nepse.v1.DepthLevel
+	8,  // 6: nepse.v1.MarketDepth.sell_depth:type_name -> nepse.v1.DepthLevel
+	26, // 7: nepse.v1.NepseIndex.generated_time:type_name -> google.protobuf.Timestamp
+	26, // 8: nepse.v1.MarketStatus.as_of:type_name -> google.protobuf.Timestamp
+	26, // 9: nepse.v1.MarketOpened.time:type_name -> google.protobuf.Timestamp
+	11, // 10: nepse.v1.MarketOpened.status:type_name -> nepse.v1.MarketStatus
+	26, // 11: nepse.v1.MarketClosed.time:type_name -> google.protobuf.Timestamp
+	11, // 12: nepse.v1.MarketClosed.status:type_name -> nepse.v1.MarketStatus
+	26, // 13: nepse.v1.PriceTick.time:type_name -> google.protobuf.Timestamp
+	0,  // 14: nepse.v1.PriceTick.quote:type_name -> nepse.v1.Quote
+	26, // 15: nepse.v1.IndexTick.time:type_name -> google.protobuf.Timestamp
+	10, // 16: nepse.v1.IndexTick.index:type_name -> nepse.v1.NepseIndex
+	26, // 17: nepse.v1.VWAPTick.time:type_name -> google.protobuf.Timestamp
+	26, // 18: nepse.v1.CircuitBreakerHit.time:type_name -> google.protobuf.Timestamp
+	0,  // 19: nepse.v1.CircuitBreakerHit.quote:type_name -> nepse.v1.Quote
+	12, // 20: nepse.v1.CircuitBreakerHit.band:type_name -> nepse.v1.PriceBand
+	13, // 21: nepse.v1.MarketEvent.market_opened:type_name -> nepse.v1.MarketOpened
+	14, // 22: nepse.v1.MarketEvent.market_closed:type_name -> nepse.v1.MarketClosed
+	15, // 23: nepse.v1.MarketEvent.price_tick:type_name -> nepse.v1.PriceTick
+	16, // 24: nepse.v1.MarketEvent.index_tick:type_name -> nepse.v1.IndexTick
+	17, // 25: nepse.v1.MarketEvent.vwap_tick:type_name -> nepse.v1.VWAPTick
+	18, // 26: nepse.v1.MarketEvent.circuit_breaker_hit:type_name -> nepse.v1.CircuitBreakerHit
+	27, // 27: nepse.v1.StreamQuotesRequest.interval:type_name -> google.protobuf.Duration
+	5,  // 28: nepse.v1.FloorSheet.entries:type_name -> nepse.v1.FloorSheetEntry
+	4,  // 29: nepse.v1.PriceHistoryList.entries:type_name -> nepse.v1.PriceHistory
+	20, // 30: nepse.v1.NepseService.GetQuote:input_type -> nepse.v1.GetQuoteRequest
+	21, // 31: nepse.v1.NepseService.StreamQuotes:input_type -> nepse.v1.StreamQuotesRequest
+	22, // 32: nepse.v1.NepseService.GetFloorSheet:input_type -> nepse.v1.GetFloorSheetRequest
+	24, // 33: nepse.v1.NepseService.GetHistory:input_type -> nepse.v1.GetHistoryRequest
+	0,  // 34: nepse.v1.NepseService.GetQuote:output_type -> nepse.v1.Quote
+	0,  // 35: nepse.v1.NepseService.StreamQuotes:output_type -> nepse.v1.Quote
+	23, // 36: nepse.v1.NepseService.GetFloorSheet:output_type -> nepse.v1.FloorSheet
+	25, // 37: nepse.v1.NepseService.GetHistory:output_type -> nepse.v1.PriceHistoryList
+	34, // [34:38] is the sub-list for method output_type
+	30, // [30:34] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
+}
+
+func init() { file_nepse_proto_init() }
+func file_nepse_proto_init() {
+	if File_nepse_proto != nil {
+		return
+	}
+	file_nepse_proto_msgTypes[3].OneofWrappers = []any{}
+	file_nepse_proto_msgTypes[4].OneofWrappers = []any{}
+	file_nepse_proto_msgTypes[6].OneofWrappers = []any{}
+	file_nepse_proto_msgTypes[7].OneofWrappers = []any{}
+	file_nepse_proto_msgTypes[19].OneofWrappers = []any{
+		(*MarketEvent_MarketOpened)(nil),
+		(*MarketEvent_MarketClosed)(nil),
+		(*MarketEvent_PriceTick)(nil),
+		(*MarketEvent_IndexTick)(nil),
+		(*MarketEvent_VwapTick)(nil),
+		(*MarketEvent_CircuitBreakerHit)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nepse_proto_rawDesc), len(file_nepse_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_nepse_proto_goTypes,
+		DependencyIndexes: file_nepse_proto_depIdxs,
+		MessageInfos:      file_nepse_proto_msgTypes,
+	}.Build()
+	File_nepse_proto = out.File
+	file_nepse_proto_goTypes = nil
+	file_nepse_proto_depIdxs = nil
+}
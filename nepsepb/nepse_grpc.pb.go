@@ -0,0 +1,262 @@
+// Protobuf schema of the public NEPSE models, version 1.
+//
+// Field numbers are stable: new fields get new numbers and removed fields are
+// reserved, never reused. The nepsepb Go package holds the types generated
+// from this file and converters to and from the nepse models; services in
+// other languages generate their types from it too.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: nepse.proto
+
+package nepsepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	NepseService_GetQuote_FullMethodName      = "/nepse.v1.NepseService/GetQuote"
+	NepseService_StreamQuotes_FullMethodName  = "/nepse.v1.NepseService/StreamQuotes"
+	NepseService_GetFloorSheet_FullMethodName = "/nepse.v1.NepseService/GetFloorSheet"
+	NepseService_GetHistory_FullMethodName    = "/nepse.v1.NepseService/GetHistory"
+)
+
+// NepseServiceClient is the client API for NepseService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// NepseService exposes the Go client over gRPC, so services in other languages
+// can use it through clients generated from this file. nepsepb.Server serves it.
+type NepseServiceClient interface {
+	// GetQuote returns the current quote of a security
+	GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*Quote, error)
+	// StreamQuotes sends the quote of each security whenever it changes, until
+	// the client cancels the call
+	StreamQuotes(ctx context.Context, in *StreamQuotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Quote], error)
+	// GetFloorSheet returns the trades of a business date
+	GetFloorSheet(ctx context.Context, in *GetFloorSheetRequest, opts ...grpc.CallOption) (*FloorSheet, error)
+	// GetHistory returns the daily price and volume history of a security
+	GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*PriceHistoryList, error)
+}
+
+type nepseServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNepseServiceClient(cc grpc.ClientConnInterface) NepseServiceClient {
+	return &nepseServiceClient{cc}
+}
+
+func (c *nepseServiceClient) GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*Quote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Quote)
+	err := c.cc.Invoke(ctx, NepseService_GetQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nepseServiceClient) StreamQuotes(ctx context.Context, in *StreamQuotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Quote], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NepseService_ServiceDesc.Streams[0], NepseService_StreamQuotes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamQuotesRequest, Quote]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NepseService_StreamQuotesClient = grpc.ServerStreamingClient[Quote]
+
+func (c *nepseServiceClient) GetFloorSheet(ctx context.Context, in *GetFloorSheetRequest, opts ...grpc.CallOption) (*FloorSheet, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FloorSheet)
+	err := c.cc.Invoke(ctx, NepseService_GetFloorSheet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nepseServiceClient) GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*PriceHistoryList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceHistoryList)
+	err := c.cc.Invoke(ctx, NepseService_GetHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NepseServiceServer is the server API for NepseService service.
+// All implementations must embed UnimplementedNepseServiceServer
+// for forward compatibility.
+//
+// NepseService exposes the Go client over gRPC, so services in other languages
+// can use it through clients generated from this file. nepsepb.Server serves it.
+type NepseServiceServer interface {
+	// GetQuote returns the current quote of a security
+	GetQuote(context.Context, *GetQuoteRequest) (*Quote, error)
+	// StreamQuotes sends the quote of each security whenever it changes, until
+	// the client cancels the call
+	StreamQuotes(*StreamQuotesRequest, grpc.ServerStreamingServer[Quote]) error
+	// GetFloorSheet returns the trades of a business date
+	GetFloorSheet(context.Context, *GetFloorSheetRequest) (*FloorSheet, error)
+	// GetHistory returns the daily price and volume history of a security
+	GetHistory(context.Context, *GetHistoryRequest) (*PriceHistoryList, error)
+	mustEmbedUnimplementedNepseServiceServer()
+}
+
+// UnimplementedNepseServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNepseServiceServer struct{}
+
+func (UnimplementedNepseServiceServer) GetQuote(context.Context, *GetQuoteRequest) (*Quote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuote not implemented")
+}
+func (UnimplementedNepseServiceServer) StreamQuotes(*StreamQuotesRequest, grpc.ServerStreamingServer[Quote]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamQuotes not implemented")
+}
+func (UnimplementedNepseServiceServer) GetFloorSheet(context.Context, *GetFloorSheetRequest) (*FloorSheet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFloorSheet not implemented")
+}
+func (UnimplementedNepseServiceServer) GetHistory(context.Context, *GetHistoryRequest) (*PriceHistoryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistory not implemented")
+}
+func (UnimplementedNepseServiceServer) mustEmbedUnimplementedNepseServiceServer() {}
+func (UnimplementedNepseServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeNepseServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NepseServiceServer will
+// result in compilation errors.
+type UnsafeNepseServiceServer interface {
+	mustEmbedUnimplementedNepseServiceServer()
+}
+
+func RegisterNepseServiceServer(s grpc.ServiceRegistrar, srv NepseServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNepseServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NepseService_ServiceDesc, srv)
+}
+
+func _NepseService_GetQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NepseServiceServer).GetQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NepseService_GetQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NepseServiceServer).GetQuote(ctx, req.(*GetQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NepseService_StreamQuotes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamQuotesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NepseServiceServer).StreamQuotes(m, &grpc.GenericServerStream[StreamQuotesRequest, Quote]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NepseService_StreamQuotesServer = grpc.ServerStreamingServer[Quote]
+
+func _NepseService_GetFloorSheet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFloorSheetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NepseServiceServer).GetFloorSheet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NepseService_GetFloorSheet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NepseServiceServer).GetFloorSheet(ctx, req.(*GetFloorSheetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NepseService_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NepseServiceServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NepseService_GetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NepseServiceServer).GetHistory(ctx, req.(*GetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NepseService_ServiceDesc is the grpc.ServiceDesc for NepseService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NepseService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nepse.v1.NepseService",
+	HandlerType: (*NepseServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetQuote",
+			Handler:    _NepseService_GetQuote_Handler,
+		},
+		{
+			MethodName: "GetFloorSheet",
+			Handler:    _NepseService_GetFloorSheet_Handler,
+		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _NepseService_GetHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamQuotes",
+			Handler:       _NepseService_StreamQuotes_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "nepse.proto",
+}
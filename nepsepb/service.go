@@ -0,0 +1,139 @@
+package nepsepb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/voidarchive/nepseauth/nepse"
+)
+
+// DefaultStreamInterval is the polling interval of StreamQuotes calls that set
+// none
+const DefaultStreamInterval = 5 * time.Second
+
+// Server implements the generated NepseServiceServer by delegating to a
+// nepse.Client. Register it on a grpc.Server, which serves plaintext and TLS
+// clients alike:
+//
+//	s := grpc.NewServer()
+//	nepsepb.RegisterNepseServiceServer(s, nepsepb.NewServer(client))
+//	s.Serve(lis)
+//
+// Client errors map to gRPC status codes, e.g. ErrNotFound to NotFound.
+type Server struct {
+	UnimplementedNepseServiceServer
+	client nepse.Client
+}
+
+// NewServer returns a server delegating to client
+func NewServer(client nepse.Client) *Server {
+	return &Server{client: client}
+}
+
+// GetQuote implements NepseServiceServer
+func (s *Server) GetQuote(ctx context.Context, req *GetQuoteRequest) (*Quote, error) {
+	details, err := s.client.GetCompanyDetailsBySymbol(ctx, req.Symbol)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	quote := details.Quote()
+	return QuoteToProto(&quote), nil
+}
+
+// StreamQuotes implements NepseServiceServer
+func (s *Server) StreamQuotes(req *StreamQuotesRequest, stream grpc.ServerStreamingServer[Quote]) error {
+	if len(req.Symbols) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one symbol is required")
+	}
+	interval := req.Interval.AsDuration()
+	if interval <= 0 {
+		interval = DefaultStreamInterval
+	}
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	merged := make(chan nepse.Quote)
+	for _, symbol := range req.Symbols {
+		quotes, err := s.client.SubscribeQuote(ctx, symbol, interval)
+		if err != nil {
+			return statusError(err)
+		}
+		go func() {
+			for quote := range quotes {
+				select {
+				case merged <- quote:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return statusError(ctx.Err())
+		case quote := <-merged:
+			if err := stream.Send(QuoteToProto(&quote)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// GetFloorSheet implements NepseServiceServer
+func (s *Server) GetFloorSheet(ctx context.Context, req *GetFloorSheetRequest) (*FloorSheet, error) {
+	var entries []nepse.FloorSheetEntry
+	var err error
+	if req.Symbol == "" {
+		entries, err = s.client.GetFloorSheetAll(ctx, req.BusinessDate, nil)
+	} else {
+		entries, err = s.client.GetFloorSheetBySymbol(ctx, req.Symbol, req.BusinessDate)
+	}
+	if err != nil {
+		return nil, statusError(err)
+	}
+	result := &FloorSheet{Entries: make([]*FloorSheetEntry, len(entries))}
+	for i := range entries {
+		result.Entries[i] = FloorSheetEntryToProto(&entries[i])
+	}
+	return result, nil
+}
+
+// GetHistory implements NepseServiceServer
+func (s *Server) GetHistory(ctx context.Context, req *GetHistoryRequest) (*PriceHistoryList, error) {
+	history, err := s.client.GetPriceVolumeHistoryBySymbol(ctx, req.Symbol, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	result := &PriceHistoryList{Entries: make([]*PriceHistory, len(history))}
+	for i := range history {
+		result.Entries[i] = PriceHistoryToProto(&history[i])
+	}
+	return result, nil
+}
+
+// statusError converts a client error to a gRPC status error
+func statusError(err error) error {
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	}
+	code := codes.Unknown
+	switch nepse.CodeOf(err) {
+	case nepse.ErrorTypeInvalidArgument, nepse.ErrorTypeInvalidClientRequest:
+		code = codes.InvalidArgument
+	case nepse.ErrorTypeNotFound:
+		code = codes.NotFound
+	case nepse.ErrorTypeRateLimit:
+		code = codes.ResourceExhausted
+	case nepse.ErrorTypeNetworkError, nepse.ErrorTypeTokenExpired, nepse.ErrorTypeUnauthorized:
+		code = codes.Unavailable
+	case nepse.ErrorTypeInvalidServerResponse, nepse.ErrorTypeInternal:
+		code = codes.Internal
+	}
+	return status.Error(code, err.Error())
+}
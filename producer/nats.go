@@ -0,0 +1,416 @@
+package producer
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// NATSConfig configures a NATSClient
+type NATSConfig struct {
+	// URL of the server, e.g. "nats://localhost:4222"; "tls://" asks for TLS.
+	// User and password may be given in the URL.
+	URL string
+
+	// Name identifies the connection in the server's monitoring
+	Name string
+
+	User     string
+	Password string
+	Token    string
+
+	// TLS, if set, is used even if the server doesn't require TLS
+	TLS *tls.Config
+
+	// Dialer, if set, replaces the default net.Dialer
+	Dialer *net.Dialer
+}
+
+// NATSClient is a Producer publishing records to NATS. The subject of a record
+// is its topic followed by its key as the last token, e.g. nepse.quotes.NABIL,
+// so subscribers can pick symbols with wildcards. Headers are sent when the
+// server supports them.
+//
+// Publishing is fire-and-forget as in NATS; call Flush to wait until the server
+// processed everything published so far. The client connects on the first
+// record and reconnects on the next record after the connection breaks. Safe
+// for concurrent use.
+type NATSClient struct {
+	config NATSConfig
+
+	mu     sync.Mutex
+	conn   *natsConn
+	closed bool
+}
+
+// NewNATSClient returns a client for the server of config. It does not connect
+// yet.
+func NewNATSClient(config NATSConfig) *NATSClient {
+	return &NATSClient{config: config}
+}
+
+// Connect connects to the server if the client is not connected yet
+func (c *NATSClient) Connect(ctx context.Context) error {
+	_, err := c.connection(ctx)
+	return err
+}
+
+// Produce implements Producer
+func (c *NATSClient) Produce(ctx context.Context, r Record) error {
+	subject := r.Topic
+	if len(r.Key) > 0 {
+		subject += "." + subjectToken(string(r.Key))
+	}
+	if subject == "" || strings.ContainsAny(subject, " \t\r\n*>") {
+		return fmt.Errorf("nats: invalid subject %q", subject)
+	}
+	cn, err := c.connection(ctx)
+	if err != nil {
+		return err
+	}
+	if max := cn.info.MaxPayload; max > 0 && len(r.Value) > max {
+		return fmt.Errorf("nats: record of %d bytes exceeds the server's maximum payload of %d", len(r.Value), max)
+	}
+	if err := cn.write(cn.publishCommand(subject, r)); err != nil {
+		c.drop(cn)
+		return fmt.Errorf("nats: failed to publish to %q: %w", subject, err)
+	}
+	return nil
+}
+
+// Flush waits until the server processed every record published so far
+func (c *NATSClient) Flush(ctx context.Context) error {
+	c.mu.Lock()
+	cn := c.conn
+	c.mu.Unlock()
+	if cn == nil {
+		return nil
+	}
+	return cn.ping(ctx)
+}
+
+// Close disconnects from the server. Later records fail.
+func (c *NATSClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	if c.conn != nil {
+		c.conn.close()
+		c.conn = nil
+	}
+	return nil
+}
+
+// connection returns the current connection, dialing a new one if needed
+func (c *NATSClient) connection(ctx context.Context) (*natsConn, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil, errors.New("nats: client closed")
+	}
+	if c.conn != nil && !c.conn.broken() {
+		return c.conn, nil
+	}
+	if c.conn != nil {
+		c.conn.close()
+		c.conn = nil
+	}
+	cn, err := dialNATS(ctx, c.config)
+	if err != nil {
+		return nil, err
+	}
+	c.conn = cn
+	return cn, nil
+}
+
+// drop closes cn if it is still the current connection
+func (c *NATSClient) drop(cn *natsConn) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conn == cn {
+		c.conn = nil
+	}
+	cn.close()
+}
+
+// natsInfo is the part of the server's INFO the client uses
+type natsInfo struct {
+	Headers     bool `json:"headers"`
+	TLSRequired bool `json:"tls_required"`
+	MaxPayload  int  `json:"max_payload"`
+}
+
+// natsConn is one connection to the server
+type natsConn struct {
+	nc   net.Conn
+	info natsInfo
+
+	writeMu sync.Mutex
+
+	mu    sync.Mutex
+	pongs []chan struct{}
+	err   error
+
+	done chan struct{}
+}
+
+// dialNATS connects and completes the handshake
+func dialNATS(ctx context.Context, config NATSConfig) (*natsConn, error) {
+	u, err := url.Parse(config.URL)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("nats: invalid server URL %q", config.URL)
+	}
+	useTLS := config.TLS != nil
+	switch u.Scheme {
+	case "nats":
+	case "tls":
+		useTLS = true
+	default:
+		return nil, fmt.Errorf("nats: unsupported URL scheme %q", u.Scheme)
+	}
+	address := u.Host
+	if u.Port() == "" {
+		address = net.JoinHostPort(u.Hostname(), "4222")
+	}
+	dialer := config.Dialer
+	if dialer == nil {
+		dialer = &net.Dialer{}
+	}
+	nc, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("nats: failed to connect to %s: %w", address, err)
+	}
+	cn, err := handshakeNATS(ctx, nc, u, config, useTLS)
+	if err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("nats: failed to connect to %s: %w", address, err)
+	}
+	return cn, nil
+}
+
+// handshakeNATS reads the server's INFO, upgrades to TLS if needed and sends
+// CONNECT, then waits for the PONG of a PING to know it was accepted
+func handshakeNATS(ctx context.Context, nc net.Conn, u *url.URL, config NATSConfig, useTLS bool) (*natsConn, error) {
+	if deadline, ok := ctx.Deadline(); ok {
+		nc.SetDeadline(deadline)
+	}
+	r := bufio.NewReader(nc)
+	line, err := readLine(r)
+	if err != nil {
+		return nil, err
+	}
+	payload, ok := strings.CutPrefix(line, "INFO ")
+	if !ok {
+		return nil, fmt.Errorf("unexpected greeting %q", line)
+	}
+	var info natsInfo
+	if err := json.Unmarshal([]byte(payload), &info); err != nil {
+		return nil, fmt.Errorf("invalid INFO: %w", err)
+	}
+
+	if useTLS || info.TLSRequired {
+		tlsConfig := config.TLS.Clone()
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{}
+		}
+		if tlsConfig.ServerName == "" {
+			tlsConfig.ServerName = u.Hostname()
+		}
+		tc := tls.Client(nc, tlsConfig)
+		if err := tc.HandshakeContext(ctx); err != nil {
+			return nil, err
+		}
+		nc, r = tc, bufio.NewReader(tc)
+	}
+
+	connect := map[string]any{
+		"verbose":  false,
+		"pedantic": false,
+		"lang":     "go",
+		"version":  "1.0.0",
+		"protocol": 1,
+		"headers":  info.Headers,
+	}
+	if config.Name != "" {
+		connect["name"] = config.Name
+	}
+	user, password := config.User, config.Password
+	if u.User != nil {
+		user = u.User.Username()
+		password, _ = u.User.Password()
+	}
+	if user != "" {
+		connect["user"], connect["pass"] = user, password
+	}
+	if config.Token != "" {
+		connect["auth_token"] = config.Token
+	}
+	options, err := json.Marshal(connect)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := fmt.Fprintf(nc, "CONNECT %s\r\nPING\r\n", options); err != nil {
+		return nil, err
+	}
+	for {
+		line, err := readLine(r)
+		if err != nil {
+			return nil, err
+		}
+		if line == "PONG" {
+			break
+		}
+		if reason, ok := strings.CutPrefix(line, "-ERR "); ok {
+			return nil, fmt.Errorf("connection refused: %s", strings.Trim(reason, "'"))
+		}
+	}
+	nc.SetDeadline(time.Time{})
+
+	cn := &natsConn{nc: nc, info: info, done: make(chan struct{})}
+	go cn.read(r)
+	return cn, nil
+}
+
+// publishCommand encodes a PUB, or HPUB with headers, command of r
+func (cn *natsConn) publishCommand(subject string, r Record) []byte {
+	if !cn.info.Headers || len(r.Headers) == 0 {
+		b := fmt.Appendf(nil, "PUB %s %d\r\n", subject, len(r.Value))
+		b = append(b, r.Value...)
+		return append(b, "\r\n"...)
+	}
+	header := []byte("NATS/1.0\r\n")
+	for _, k := range slices.Sorted(maps.Keys(r.Headers)) {
+		header = fmt.Appendf(header, "%s: %s\r\n", k, r.Headers[k])
+	}
+	header = append(header, "\r\n"...)
+	b := fmt.Appendf(nil, "HPUB %s %d %d\r\n", subject, len(header), len(header)+len(r.Value))
+	b = append(b, header...)
+	b = append(b, r.Value...)
+	return append(b, "\r\n"...)
+}
+
+// write writes one command
+func (cn *natsConn) write(command []byte) error {
+	if err := cn.failure(); err != nil {
+		return err
+	}
+	cn.writeMu.Lock()
+	defer cn.writeMu.Unlock()
+	if _, err := cn.nc.Write(command); err != nil {
+		cn.fail(err)
+		return err
+	}
+	return nil
+}
+
+// ping sends PING and waits for its PONG
+func (cn *natsConn) ping(ctx context.Context) error {
+	pong := make(chan struct{})
+	cn.writeMu.Lock()
+	cn.mu.Lock()
+	cn.pongs = append(cn.pongs, pong)
+	cn.mu.Unlock()
+	_, err := cn.nc.Write([]byte("PING\r\n"))
+	cn.writeMu.Unlock()
+	if err != nil {
+		cn.fail(err)
+		return fmt.Errorf("nats: failed to flush: %w", err)
+	}
+	select {
+	case <-pong:
+		return nil
+	case <-cn.done:
+		return fmt.Errorf("nats: failed to flush: %w", cn.failure())
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// read handles the server's commands until the connection fails
+func (cn *natsConn) read(r *bufio.Reader) {
+	for {
+		line, err := readLine(r)
+		if err != nil {
+			cn.fail(err)
+			return
+		}
+		switch {
+		case line == "PING":
+			cn.write([]byte("PONG\r\n"))
+		case line == "PONG":
+			cn.mu.Lock()
+			if len(cn.pongs) > 0 {
+				close(cn.pongs[0])
+				cn.pongs = cn.pongs[1:]
+			}
+			cn.mu.Unlock()
+		case strings.HasPrefix(line, "-ERR "):
+			// Permission errors leave the connection open; others close it
+			reason := strings.Trim(strings.TrimPrefix(line, "-ERR "), "'")
+			if !strings.HasPrefix(strings.ToLower(reason), "permissions violation") {
+				cn.fail(errors.New(reason))
+				return
+			}
+		}
+	}
+}
+
+// fail marks the connection broken with err and closes it
+func (cn *natsConn) fail(err error) {
+	cn.mu.Lock()
+	defer cn.mu.Unlock()
+	if cn.err != nil {
+		return
+	}
+	if errors.Is(err, io.EOF) {
+		err = errors.New("connection closed by server")
+	}
+	cn.err = err
+	close(cn.done)
+	cn.nc.Close()
+}
+
+func (cn *natsConn) failure() error {
+	cn.mu.Lock()
+	defer cn.mu.Unlock()
+	return cn.err
+}
+
+func (cn *natsConn) broken() bool {
+	return cn.failure() != nil
+}
+
+func (cn *natsConn) close() {
+	cn.fail(net.ErrClosed)
+}
+
+// readLine reads one CRLF-terminated protocol line
+func readLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// subjectToken makes a key usable as one subject token
+func subjectToken(key string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '.', ' ', '\t', '\r', '\n', '*', '>':
+			return '_'
+		}
+		return r
+	}, key)
+}
@@ -0,0 +1,204 @@
+package producer
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeNATS accepts one connection and records what the client sends
+type fakeNATS struct {
+	t    *testing.T
+	ln   net.Listener
+	info string
+	nc   chan net.Conn
+}
+
+func newFakeNATS(t *testing.T, info string) *fakeNATS {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &fakeNATS{t: t, ln: ln, info: info, nc: make(chan net.Conn, 1)}
+	go func() {
+		nc, err := ln.Accept()
+		if err == nil {
+			s.nc <- nc
+		}
+	}()
+	t.Cleanup(func() { ln.Close() })
+	return s
+}
+
+func (s *fakeNATS) url() string {
+	return "nats://" + s.ln.Addr().String()
+}
+
+// accept greets the client and answers its handshake PING, returning the
+// options of its CONNECT
+func (s *fakeNATS) accept() (net.Conn, *bufio.Reader, map[string]any) {
+	s.t.Helper()
+	var nc net.Conn
+	select {
+	case nc = <-s.nc:
+	case <-time.After(5 * time.Second):
+		s.t.Fatal("client did not connect")
+	}
+	s.t.Cleanup(func() { nc.Close() })
+	io.WriteString(nc, "INFO "+s.info+"\r\n")
+	r := bufio.NewReader(nc)
+	line := s.line(r)
+	options, ok := strings.CutPrefix(line, "CONNECT ")
+	if !ok {
+		s.t.Fatalf("first command %q, want CONNECT", line)
+	}
+	var connect map[string]any
+	if err := json.Unmarshal([]byte(options), &connect); err != nil {
+		s.t.Fatal(err)
+	}
+	if line := s.line(r); line != "PING" {
+		s.t.Fatalf("command %q, want PING", line)
+	}
+	io.WriteString(nc, "PONG\r\n")
+	return nc, r, connect
+}
+
+func (s *fakeNATS) line(r *bufio.Reader) string {
+	s.t.Helper()
+	line, err := readLine(r)
+	if err != nil {
+		s.t.Fatalf("server read: %v", err)
+	}
+	return line
+}
+
+// read reads n bytes of payload and its trailing CRLF
+func (s *fakeNATS) read(r *bufio.Reader, n int) string {
+	s.t.Helper()
+	b := make([]byte, n+2)
+	if _, err := io.ReadFull(r, b); err != nil {
+		s.t.Fatalf("server read: %v", err)
+	}
+	if string(b[n:]) != "\r\n" {
+		s.t.Fatalf("payload ends with %q, want CRLF", b[n:])
+	}
+	return string(b[:n])
+}
+
+var testRecord = Record{
+	Topic:   "nepse.quotes",
+	Key:     []byte("NABIL"),
+	Value:   []byte(`{"ltp":525.5}`),
+	Headers: map[string]string{"event-type": "PriceTick", "content-type": "application/json"},
+}
+
+func TestNATSPublish(t *testing.T) {
+	server := newFakeNATS(t, `{"headers":false,"max_payload":1048576}`)
+	client := NewNATSClient(NATSConfig{URL: server.url(), Name: "nepse", User: "user", Password: "secret"})
+	defer client.Close()
+
+	done := make(chan error, 1)
+	go func() { done <- client.Produce(context.Background(), testRecord) }()
+	_, r, connect := server.accept()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if connect["name"] != "nepse" || connect["user"] != "user" || connect["pass"] != "secret" || connect["headers"] != false {
+		t.Errorf("CONNECT options = %v", connect)
+	}
+
+	// Without server support headers are left out
+	if line := server.line(r); line != "PUB nepse.quotes.NABIL 13" {
+		t.Fatalf("command %q, want PUB nepse.quotes.NABIL 13", line)
+	}
+	if payload := server.read(r, 13); payload != `{"ltp":525.5}` {
+		t.Errorf("payload %q", payload)
+	}
+}
+
+func TestNATSPublishHeaders(t *testing.T) {
+	server := newFakeNATS(t, `{"headers":true}`)
+	client := NewNATSClient(NATSConfig{URL: server.url()})
+	defer client.Close()
+
+	done := make(chan error, 1)
+	go func() { done <- client.Produce(context.Background(), testRecord) }()
+	nc, r, connect := server.accept()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if connect["headers"] != true {
+		t.Errorf("CONNECT options = %v, want headers", connect)
+	}
+
+	header := "NATS/1.0\r\ncontent-type: application/json\r\nevent-type: PriceTick\r\n\r\n"
+	want := fmt.Sprintf("HPUB nepse.quotes.NABIL %d %d", len(header), len(header)+13)
+	if line := server.line(r); line != want {
+		t.Fatalf("command %q, want %q", line, want)
+	}
+	if payload := server.read(r, len(header)+13); payload != header+`{"ltp":525.5}` {
+		t.Errorf("payload %q", payload)
+	}
+
+	// Flush returns once the server answers its PING
+	go func() { done <- client.Flush(context.Background()) }()
+	if line := server.line(r); line != "PING" {
+		t.Fatalf("command %q, want PING", line)
+	}
+	io.WriteString(nc, "PONG\r\n")
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNATSConnectRefused(t *testing.T) {
+	server := newFakeNATS(t, `{}`)
+	go func() {
+		nc := <-server.nc
+		defer nc.Close()
+		io.WriteString(nc, "INFO {}\r\n")
+		readLine(bufio.NewReader(nc))
+		io.WriteString(nc, "-ERR 'Authorization Violation'\r\n")
+	}()
+	client := NewNATSClient(NATSConfig{URL: server.url()})
+	defer client.Close()
+	err := client.Connect(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "Authorization Violation") {
+		t.Fatalf("Connect = %v, want the server's refusal", err)
+	}
+}
+
+func TestNATSSubject(t *testing.T) {
+	cn := &natsConn{}
+	tests := []struct {
+		topic, key string
+		command    string
+	}{
+		{"nepse.indices", "sensitive-float", "PUB nepse.indices.sensitive-float 1\r\nx\r\n"},
+		{"nepse.market-status", "", "PUB nepse.market-status 1\r\nx\r\n"},
+		{"nepse.quotes", "A.B *>", "PUB nepse.quotes.A_B___ 1\r\nx\r\n"},
+	}
+	for _, tt := range tests {
+		subject := tt.topic
+		if tt.key != "" {
+			subject += "." + subjectToken(tt.key)
+		}
+		if got := string(cn.publishCommand(subject, Record{Value: []byte("x")})); got != tt.command {
+			t.Errorf("command of %s/%q = %q, want %q", tt.topic, tt.key, got, tt.command)
+		}
+	}
+
+	client := NewNATSClient(NATSConfig{URL: "nats://127.0.0.1:1"})
+	for _, topic := range []string{"", "nepse.*", "nepse quotes"} {
+		if err := client.Produce(context.Background(), Record{Topic: topic}); err == nil || !strings.Contains(err.Error(), "invalid subject") {
+			t.Errorf("Produce to %q = %v, want an invalid subject error", topic, err)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+// Package producer streams NEPSE market events into message brokers, so data
+// pipelines can ingest them without a bridge service.
+//
+// A Sink serializes the events of an events.Bus, as JSON or as nepsepb
+// MarketEvent protobuf messages, into records keyed by symbol. Records go to a
+// Producer. NATSClient is a built-in dependency-free NATS publisher; for any
+// other broker, wrap its client in a ProducerFunc:
+//
+//	sink := producer.NewSink(producer.ProducerFunc(func(ctx context.Context, r producer.Record) error {
+//		return client.Send(ctx, r.Topic, r.Key, r.Value)
+//	}), producer.SinkOptions{Encoding: producer.EncodingProtobuf})
+//	go sink.Run(ctx, bus)
+package producer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/voidarchive/nepseauth/events"
+	"github.com/voidarchive/nepseauth/nepsepb"
+)
+
+// DefaultTopicPrefix is the topic prefix of a Sink with none set
+const DefaultTopicPrefix = "nepse"
+
+// Record is a keyed message for a topic (a subject, with NATS)
+type Record struct {
+	Topic string
+	// Key is the symbol of the event, or the index of an IndexTick; empty for
+	// market status events. Brokers that partition by key keep the events of
+	// one symbol in order.
+	Key   []byte
+	Value []byte
+	// Headers carry the event type and the content type of Value
+	Headers map[string]string
+}
+
+// Producer sends records to a broker
+type Producer interface {
+	Produce(ctx context.Context, r Record) error
+}
+
+// ProducerFunc adapts a function to the Producer interface
+type ProducerFunc func(ctx context.Context, r Record) error
+
+// Produce calls f(ctx, r)
+func (f ProducerFunc) Produce(ctx context.Context, r Record) error {
+	return f(ctx, r)
+}
+
+// Encoding is the serialization of record values
+type Encoding string
+
+const (
+	// EncodingJSON encodes each event as the JSON of its events type
+	EncodingJSON Encoding = "json"
+	// EncodingProtobuf encodes each event as a nepse.v1.MarketEvent message
+	// of nepsepb/nepse.proto
+	EncodingProtobuf Encoding = "protobuf"
+)
+
+// contentTypes are the content-type headers of the encodings
+var contentTypes = map[Encoding]string{
+	EncodingJSON:     "application/json",
+	EncodingProtobuf: "application/x-protobuf",
+}
+
+// SinkOptions configures a Sink
+type SinkOptions struct {
+	// Prefix is prepended to every topic (DefaultTopicPrefix if empty)
+	Prefix string
+
+	// Encoding of record values (EncodingJSON if empty)
+	Encoding Encoding
+
+	// Buffer is the number of events buffered while producing lags (256 if
+	// zero); events beyond it are dropped and counted by the bus
+	Buffer int
+
+	// OnError, if set, receives the errors of failed records. Producing
+	// continues with the next event.
+	OnError func(error)
+}
+
+// Sink produces the events of a bus as records, one topic per event kind:
+//
+//	<prefix>.quotes           PriceTick, keyed by symbol
+//	<prefix>.vwap             VWAPTick, keyed by symbol
+//	<prefix>.circuit-breakers CircuitBreakerHit, keyed by symbol
+//	<prefix>.indices          IndexTick, keyed by index (nepse, sensitive, float or sensitive-float)
+//	<prefix>.market-status    MarketOpened and MarketClosed, without key
+type Sink struct {
+	producer Producer
+	opts     SinkOptions
+}
+
+// NewSink returns a sink producing to producer. It panics on an unknown
+// encoding.
+func NewSink(producer Producer, opts SinkOptions) *Sink {
+	if opts.Prefix == "" {
+		opts.Prefix = DefaultTopicPrefix
+	}
+	opts.Prefix = strings.TrimSuffix(opts.Prefix, ".")
+	if opts.Encoding == "" {
+		opts.Encoding = EncodingJSON
+	}
+	if _, ok := contentTypes[opts.Encoding]; !ok {
+		panic(fmt.Sprintf("producer: unknown encoding %q", opts.Encoding))
+	}
+	if opts.Buffer <= 0 {
+		opts.Buffer = 256
+	}
+	return &Sink{producer: producer, opts: opts}
+}
+
+// Run produces the events of bus until ctx is done or the bus is closed, and
+// returns ctx's error
+func (s *Sink) Run(ctx context.Context, bus *events.Bus) error {
+	all, cancel := events.SubscribeAll(bus, s.opts.Buffer)
+	defer cancel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case e, ok := <-all:
+			if !ok {
+				return ctx.Err()
+			}
+			if err := s.Produce(ctx, e); err != nil && ctx.Err() == nil && s.opts.OnError != nil {
+				s.opts.OnError(err)
+			}
+		}
+	}
+}
+
+// Produce produces one event. Events of kinds the sink doesn't produce are
+// skipped.
+func (s *Sink) Produce(ctx context.Context, e events.Event) error {
+	r, ok, err := s.Record(e)
+	if err != nil || !ok {
+		return err
+	}
+	if err := s.producer.Produce(ctx, r); err != nil {
+		return fmt.Errorf("producer: failed to produce to %s: %w", r.Topic, err)
+	}
+	return nil
+}
+
+// Record returns the record of an event; ok is false for event kinds the sink
+// doesn't produce
+func (s *Sink) Record(e events.Event) (r Record, ok bool, err error) {
+	kind, topic, key := classify(e)
+	if kind == "" {
+		return Record{}, false, nil
+	}
+	var value []byte
+	switch s.opts.Encoding {
+	case EncodingProtobuf:
+		value, err = nepsepb.MarshalEvent(e)
+	default:
+		value, err = json.Marshal(e)
+	}
+	if err != nil {
+		return Record{}, false, fmt.Errorf("producer: failed to encode %T: %w", e, err)
+	}
+	r = Record{
+		Topic: s.opts.Prefix + "." + topic,
+		Value: value,
+		Headers: map[string]string{
+			"event-type":   kind,
+			"content-type": contentTypes[s.opts.Encoding],
+		},
+	}
+	if key != "" {
+		r.Key = []byte(key)
+	}
+	return r, true, nil
+}
+
+// classify returns the event type name, topic and key of an event
+func classify(e events.Event) (kind, topic, key string) {
+	switch e := e.(type) {
+	case events.PriceTick:
+		return "PriceTick", "quotes", e.Quote.Symbol
+	case events.VWAPTick:
+		return "VWAPTick", "vwap", e.Symbol
+	case events.CircuitBreakerHit:
+		return "CircuitBreakerHit", "circuit-breakers", e.Quote.Symbol
+	case events.IndexTick:
+		return "IndexTick", "indices", strings.ReplaceAll(strings.ToLower(e.Name), " ", "-")
+	case events.MarketOpened:
+		return "MarketOpened", "market-status", ""
+	case events.MarketClosed:
+		return "MarketClosed", "market-status", ""
+	}
+	return "", "", ""
+}
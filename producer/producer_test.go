@@ -0,0 +1,89 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/voidarchive/nepseauth/events"
+	"github.com/voidarchive/nepseauth/nepse"
+	"github.com/voidarchive/nepseauth/nepsepb"
+)
+
+func TestSinkRecord(t *testing.T) {
+	now := time.Date(2024, 5, 12, 11, 30, 0, 0, nepse.NepalLocation)
+	tests := []struct {
+		event events.Event
+		kind  string
+		topic string
+		key   string
+	}{
+		{events.PriceTick{Time: now, Quote: nepse.Quote{Symbol: "NABIL", LastTradedPrice: 525.5}}, "PriceTick", "nepse.quotes", "NABIL"},
+		{events.VWAPTick{Time: now, Symbol: "NICA", VWAP: 404.9, Volume: 1200}, "VWAPTick", "nepse.vwap", "NICA"},
+		{events.CircuitBreakerHit{Time: now, Quote: nepse.Quote{Symbol: "HDL"}, Upper: true}, "CircuitBreakerHit", "nepse.circuit-breakers", "HDL"},
+		{events.IndexTick{Time: now, Name: "Sensitive Float"}, "IndexTick", "nepse.indices", "sensitive-float"},
+		{events.MarketOpened{Time: now}, "MarketOpened", "nepse.market-status", ""},
+		{events.MarketClosed{Time: now}, "MarketClosed", "nepse.market-status", ""},
+	}
+	sink := NewSink(ProducerFunc(nil), SinkOptions{})
+	for _, tt := range tests {
+		r, ok, err := sink.Record(tt.event)
+		if err != nil || !ok {
+			t.Fatalf("Record(%T) = %v, %v", tt.event, ok, err)
+		}
+		if r.Topic != tt.topic || string(r.Key) != tt.key {
+			t.Errorf("%T went to %s with key %q, want %s with key %q", tt.event, r.Topic, r.Key, tt.topic, tt.key)
+		}
+		if tt.key == "" && r.Key != nil {
+			t.Errorf("%T has key %q, want none", tt.event, r.Key)
+		}
+		if r.Headers["event-type"] != tt.kind || r.Headers["content-type"] != "application/json" {
+			t.Errorf("%T headers = %v", tt.event, r.Headers)
+		}
+		want, _ := json.Marshal(tt.event)
+		if string(r.Value) != string(want) {
+			t.Errorf("%T value = %s, want %s", tt.event, r.Value, want)
+		}
+	}
+
+	if _, ok, err := sink.Record(struct{ events.MarketOpened }{}); ok || err != nil {
+		t.Errorf("Record of an unknown event = %v, %v, want it skipped", ok, err)
+	}
+}
+
+func TestSinkRecordProtobuf(t *testing.T) {
+	sink := NewSink(ProducerFunc(nil), SinkOptions{Prefix: "market.", Encoding: EncodingProtobuf})
+	tick := events.VWAPTick{Time: time.Date(2024, 5, 12, 11, 30, 0, 0, time.UTC), Symbol: "NABIL", VWAP: 522.63, Volume: 3000}
+	r, ok, err := sink.Record(tick)
+	if err != nil || !ok {
+		t.Fatalf("Record = %v, %v", ok, err)
+	}
+	if r.Topic != "market.vwap" || r.Headers["content-type"] != "application/x-protobuf" {
+		t.Errorf("topic %s, headers %v", r.Topic, r.Headers)
+	}
+	e, err := nepsepb.UnmarshalEvent(r.Value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := e.(events.VWAPTick); !ok || got.Symbol != "NABIL" || got.VWAP != 522.63 || got.Volume != 3000 {
+		t.Errorf("decoded %#v, want %#v", e, tick)
+	}
+}
+
+func TestSinkProduce(t *testing.T) {
+	var got []Record
+	sink := NewSink(ProducerFunc(func(ctx context.Context, r Record) error {
+		got = append(got, r)
+		return nil
+	}), SinkOptions{})
+	if err := sink.Produce(context.Background(), events.PriceTick{Quote: nepse.Quote{Symbol: "NABIL"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Produce(context.Background(), struct{ events.MarketOpened }{}); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Topic != "nepse.quotes" {
+		t.Errorf("produced %+v, want one nepse.quotes record", got)
+	}
+}
@@ -0,0 +1,311 @@
+// Package proxy serves the NEPSE API locally to many consumers through one
+// shared client, so they need neither tokens nor payload IDs, and NEPSE sees
+// one well-behaved connection instead of one per consumer:
+//
+//	p := proxy.New(client, proxy.Options{})
+//	http.ListenAndServe("127.0.0.1:8080", p)
+//
+// Consumers then call the NEPSE paths on the proxy, e.g.
+// GET http://127.0.0.1:8080/api/nots/nepse-data/today-price?size=500. Responses
+// are cached for a TTL depending on how often the data changes, concurrent
+// identical requests are sent upstream once, and upstream requests go through
+// the client's rate limiter and retries.
+package proxy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/voidarchive/nepseauth/nepse"
+)
+
+// Defaults of Options
+const (
+	DefaultTTL        = 5 * time.Second
+	DefaultMaxEntries = 1000
+	DefaultTimeout    = 60 * time.Second
+)
+
+// maxBodySize bounds the request bodies accepted from consumers
+const maxBodySize = 1 << 20
+
+// DefaultTTLs are the cache TTLs of reference data that changes rarely. Keys
+// ending in "/" match every path under them; other keys match one path.
+func DefaultTTLs() map[string]time.Duration {
+	return map[string]time.Duration{
+		"/api/nots/security":               time.Hour,
+		"/api/nots/company/list":           time.Hour,
+		"/api/nots/sector":                 time.Hour,
+		"/api/nots/member":                 time.Hour,
+		"/api/nots/holiday/list":           time.Hour,
+		"/api/nots/index/constituents/":    time.Hour,
+		"/api/nots/security/disclosure/":   10 * time.Minute,
+		"/api/nots/security/dividend/":     10 * time.Minute,
+		"/api/nots/security/agm/":          10 * time.Minute,
+		"/api/nots/market/history/":        10 * time.Minute,
+		"/api/nots/index/history/":         10 * time.Minute,
+		"/api/nots/market-summary-history": 10 * time.Minute,
+	}
+}
+
+// Options configures a Proxy
+type Options struct {
+	// DefaultTTL is how long responses are cached (DefaultTTL if zero);
+	// negative disables caching
+	DefaultTTL time.Duration
+
+	// TTLs overrides DefaultTTL by path as in DefaultTTLs, the longest
+	// matching key winning (DefaultTTLs if nil). A negative TTL disables
+	// caching.
+	TTLs map[string]time.Duration
+
+	// MaxEntries bounds the number of cached responses (DefaultMaxEntries if
+	// zero)
+	MaxEntries int
+
+	// Timeout bounds each upstream request (DefaultTimeout if zero). It is
+	// not tied to the consumer's request, whose cancellation would otherwise
+	// fail the other consumers waiting for the same response.
+	Timeout time.Duration
+}
+
+// Stats are the counters of a Proxy
+type Stats struct {
+	// Hits are the requests answered from the cache
+	Hits int64
+	// Misses are the requests sent upstream, and Shared the requests that
+	// waited for an identical request already sent upstream
+	Misses int64
+	Shared int64
+	// Errors are the upstream requests that failed
+	Errors int64
+	// Entries is the number of cached responses
+	Entries int
+}
+
+// Proxy is an http.Handler forwarding NEPSE API requests through a shared
+// client. It answers GET and POST requests to /api/ paths; other requests get
+// 404 or 405. Responses carry X-Cache: HIT or MISS.
+type Proxy struct {
+	client nepse.Client
+	opts   Options
+	flight singleflight.Group
+
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+
+	hits, misses, shared, errors atomic.Int64
+}
+
+// cacheEntry is a cached upstream response
+type cacheEntry struct {
+	body    []byte
+	expires time.Time
+}
+
+// New returns a proxy forwarding through client
+func New(client nepse.Client, opts Options) *Proxy {
+	if opts.DefaultTTL == 0 {
+		opts.DefaultTTL = DefaultTTL
+	}
+	if opts.TTLs == nil {
+		opts.TTLs = DefaultTTLs()
+	}
+	if opts.MaxEntries <= 0 {
+		opts.MaxEntries = DefaultMaxEntries
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultTimeout
+	}
+	return &Proxy{client: client, opts: opts, cache: make(map[string]cacheEntry)}
+}
+
+// ServeHTTP implements http.Handler
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.URL.Path, "/api/") {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var body []byte
+	if r.Method == http.MethodPost {
+		var err error
+		if body, err = io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize)); err != nil {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+	}
+	path := r.URL.EscapedPath()
+	if r.URL.RawQuery != "" {
+		path += "?" + r.URL.RawQuery
+	}
+
+	data, ttl, cached, err := p.fetch(r.Method, path, body)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if cached {
+		w.Header().Set("X-Cache", "HIT")
+	} else {
+		w.Header().Set("X-Cache", "MISS")
+	}
+	if ttl > 0 {
+		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", int(ttl.Seconds())))
+	} else {
+		w.Header().Set("Cache-Control", "no-store")
+	}
+	w.Write(data)
+}
+
+// Stats returns the proxy's counters
+func (p *Proxy) Stats() Stats {
+	p.mu.Lock()
+	entries := len(p.cache)
+	p.mu.Unlock()
+	return Stats{
+		Hits:    p.hits.Load(),
+		Misses:  p.misses.Load(),
+		Shared:  p.shared.Load(),
+		Errors:  p.errors.Load(),
+		Entries: entries,
+	}
+}
+
+// Purge empties the cache
+func (p *Proxy) Purge() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	clear(p.cache)
+}
+
+// fetch returns the response to a request from the cache or upstream, with its
+// TTL and whether it was cached
+func (p *Proxy) fetch(method, path string, body []byte) ([]byte, time.Duration, bool, error) {
+	key := method + " " + path
+	if len(body) > 0 {
+		sum := sha256.Sum256(body)
+		key += " " + hex.EncodeToString(sum[:])
+	}
+	ttl := p.ttl(path)
+	if data, ok := p.lookup(key); ok {
+		p.hits.Add(1)
+		return data, ttl, true, nil
+	}
+
+	leader := false
+	v, err, shared := p.flight.Do(key, func() (any, error) {
+		leader = true
+		p.misses.Add(1)
+		ctx, cancel := context.WithTimeout(context.Background(), p.opts.Timeout)
+		defer cancel()
+		data, err := p.client.Forward(ctx, method, path, body)
+		if err != nil {
+			p.errors.Add(1)
+			return nil, err
+		}
+		if ttl > 0 {
+			p.store(key, data, ttl)
+		}
+		return data, nil
+	})
+	if shared && !leader {
+		p.shared.Add(1)
+	}
+	if err != nil {
+		return nil, 0, false, err
+	}
+	return v.([]byte), ttl, false, nil
+}
+
+// ttl returns the cache TTL of a path
+func (p *Proxy) ttl(path string) time.Duration {
+	path, _, _ = strings.Cut(path, "?")
+	ttl, longest := p.opts.DefaultTTL, -1
+	for key, d := range p.opts.TTLs {
+		match := path == key || strings.HasSuffix(key, "/") && strings.HasPrefix(path, key)
+		if match && len(key) > longest {
+			ttl, longest = d, len(key)
+		}
+	}
+	return ttl
+}
+
+func (p *Proxy) lookup(key string) ([]byte, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	entry, ok := p.cache[key]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.body, true
+}
+
+// store caches a response, evicting expired entries, then the entries closest
+// to expiry, when the cache is full
+func (p *Proxy) store(key string, data []byte, ttl time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+	if _, ok := p.cache[key]; !ok && len(p.cache) >= p.opts.MaxEntries {
+		for k, entry := range p.cache {
+			if now.After(entry.expires) {
+				delete(p.cache, k)
+			}
+		}
+		for len(p.cache) >= p.opts.MaxEntries {
+			var oldest string
+			var expires time.Time
+			for k, entry := range p.cache {
+				if oldest == "" || entry.expires.Before(expires) {
+					oldest, expires = k, entry.expires
+				}
+			}
+			delete(p.cache, oldest)
+		}
+	}
+	p.cache[key] = cacheEntry{body: data, expires: now.Add(ttl)}
+}
+
+// writeError answers with the HTTP status matching err and a JSON body
+// carrying its code and message
+func writeError(w http.ResponseWriter, err error) {
+	code := nepse.CodeOf(err)
+	status := http.StatusBadGateway
+	switch code {
+	case nepse.ErrorTypeInvalidArgument, nepse.ErrorTypeInvalidClientRequest:
+		status = http.StatusBadRequest
+	case nepse.ErrorTypeNotFound:
+		status = http.StatusNotFound
+	case nepse.ErrorTypeRateLimit:
+		status = http.StatusTooManyRequests
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		status = http.StatusGatewayTimeout
+	}
+	var ne *nepse.NepseError
+	if errors.As(err, &ne) && ne.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(ne.RetryAfter.Seconds())))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"code": string(code), "error": err.Error()})
+}
@@ -0,0 +1,266 @@
+// Package server serves live NEPSE data to web frontends as Server-Sent
+// Events. One polling loop is shared by every connected browser:
+//
+//	live := server.New(client, server.Options{AllowOrigin: "*"})
+//	go live.Run(ctx)
+//	http.Handle("/stream/", live)
+//
+// A page then listens with the browser's EventSource:
+//
+//	const quotes = new EventSource("/stream/quotes?symbols=NABIL,NICA");
+//	quotes.addEventListener("quote", (e) => render(JSON.parse(e.data)));
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/voidarchive/nepseauth/events"
+	"github.com/voidarchive/nepseauth/nepse"
+)
+
+// Defaults of Options
+const (
+	DefaultBuffer    = 512
+	DefaultKeepAlive = 15 * time.Second
+)
+
+// cacheBuffer buffers the events of the latest-value cache; one poll can
+// change the quote of every listed security
+const cacheBuffer = 2048
+
+// Options configures a Server
+type Options struct {
+	// Interval and OffHoursInterval are the polling intervals of the shared
+	// events.Source, with the same defaults
+	Interval         time.Duration
+	OffHoursInterval time.Duration
+
+	// Buffer is the number of events buffered per connection (DefaultBuffer if
+	// zero). A poll can change hundreds of quotes at once; a connection too
+	// slow to take them misses events.
+	Buffer int
+
+	// KeepAlive is the interval of comment lines keeping idle connections
+	// open through proxies (DefaultKeepAlive if zero)
+	KeepAlive time.Duration
+
+	// AllowOrigin, if set, is sent as Access-Control-Allow-Origin so pages of
+	// other origins can connect, e.g. "*"
+	AllowOrigin string
+
+	// OnError, if set, receives the errors of failed polls
+	OnError func(error)
+}
+
+// Server is an http.Handler serving two event streams:
+//
+//	GET /stream/index[?names=nepse,sensitive]  "index" events, each an events.IndexTick
+//	GET /stream/quotes[?symbols=NABIL,NICA]    "quote" events, each a nepse.Quote
+//
+// Without a filter every index or quote is streamed. A new connection first
+// receives the latest value of each index or quote it asked for, then every
+// change. Events only flow while Run is running.
+type Server struct {
+	client nepse.Client
+	bus    *events.Bus
+	opts   Options
+	mux    *http.ServeMux
+
+	mu      sync.RWMutex
+	indices map[string]events.IndexTick
+	quotes  map[string]nepse.Quote
+}
+
+// New returns a server streaming the data of client
+func New(client nepse.Client, opts Options) *Server {
+	if opts.Buffer <= 0 {
+		opts.Buffer = DefaultBuffer
+	}
+	if opts.KeepAlive <= 0 {
+		opts.KeepAlive = DefaultKeepAlive
+	}
+	s := &Server{
+		client:  client,
+		bus:     events.NewBus(),
+		opts:    opts,
+		mux:     http.NewServeMux(),
+		indices: make(map[string]events.IndexTick),
+		quotes:  make(map[string]nepse.Quote),
+	}
+	s.mux.HandleFunc("GET /stream/index", s.handleIndex)
+	s.mux.HandleFunc("GET /stream/quotes", s.handleQuotes)
+	return s
+}
+
+// Run polls NEPSE and feeds the streams until ctx is done, then closes every
+// stream and returns ctx's error. Run must be called once.
+func (s *Server) Run(ctx context.Context) error {
+	all, cancel := events.SubscribeAll(s.bus, cacheBuffer)
+	defer cancel()
+	source := events.NewSource(s.client, s.bus, events.SourceOptions{
+		Interval:         s.opts.Interval,
+		OffHoursInterval: s.opts.OffHoursInterval,
+		OnError:          s.opts.OnError,
+	})
+	done := make(chan error, 1)
+	go func() { done <- source.Run(ctx) }()
+	for {
+		select {
+		case <-ctx.Done():
+			err := <-done
+			s.bus.Close()
+			return err
+		case e := <-all:
+			s.remember(e)
+		}
+	}
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.opts.AllowOrigin != "" {
+		w.Header().Set("Access-Control-Allow-Origin", s.opts.AllowOrigin)
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+// remember keeps the latest value of each index and quote for new connections
+func (s *Server) remember(e events.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch e := e.(type) {
+	case events.IndexTick:
+		s.indices[indexName(e.Name)] = e
+	case events.PriceTick:
+		s.quotes[e.Quote.Symbol] = e.Quote
+	}
+}
+
+func (s *Server) handleIndex(w http.ResponseWriter, r *http.Request) {
+	names := filter(r, "names", indexName)
+	ticks, cancel := events.Subscribe[events.IndexTick](s.bus, s.opts.Buffer)
+	defer cancel()
+
+	s.mu.RLock()
+	snapshot := make([]events.IndexTick, 0, len(s.indices))
+	for name, tick := range s.indices {
+		if names == nil || names[name] {
+			snapshot = append(snapshot, tick)
+		}
+	}
+	s.mu.RUnlock()
+	slices.SortFunc(snapshot, func(x, y events.IndexTick) int { return strings.Compare(x.Name, y.Name) })
+
+	stream(s, w, r, "index", snapshot, ticks, func(tick events.IndexTick) (any, bool) {
+		return tick, names == nil || names[indexName(tick.Name)]
+	})
+}
+
+func (s *Server) handleQuotes(w http.ResponseWriter, r *http.Request) {
+	symbols := filter(r, "symbols", nepse.NormalizeSymbol)
+	ticks, cancel := events.Subscribe[events.PriceTick](s.bus, s.opts.Buffer)
+	defer cancel()
+
+	s.mu.RLock()
+	snapshot := make([]nepse.Quote, 0, len(s.quotes))
+	for symbol, quote := range s.quotes {
+		if symbols == nil || symbols[symbol] {
+			snapshot = append(snapshot, quote)
+		}
+	}
+	s.mu.RUnlock()
+	slices.SortFunc(snapshot, func(x, y nepse.Quote) int { return strings.Compare(x.Symbol, y.Symbol) })
+
+	stream(s, w, r, "quote", snapshot, ticks, func(tick events.PriceTick) (any, bool) {
+		return tick.Quote, symbols == nil || symbols[tick.Quote.Symbol]
+	})
+}
+
+// stream writes the snapshot, then each event of ch that pick accepts, as SSE
+// events named name until the client disconnects or the server stops
+func stream[S any, E events.Event](s *Server, w http.ResponseWriter, r *http.Request, name string, snapshot []S, ch <-chan E, pick func(E) (any, bool)) {
+	rc := http.NewResponseController(w)
+	h := w.Header()
+	h.Set("Content-Type", "text/event-stream")
+	h.Set("Cache-Control", "no-cache")
+	h.Set("Connection", "keep-alive")
+	h.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	for _, v := range snapshot {
+		if writeEvent(w, name, v) != nil {
+			return
+		}
+	}
+	if rc.Flush() != nil {
+		return
+	}
+
+	keepAlive := time.NewTicker(s.opts.KeepAlive)
+	defer keepAlive.Stop()
+	for {
+		var err error
+		select {
+		case <-r.Context().Done():
+			return
+		case <-keepAlive.C:
+			_, err = fmt.Fprint(w, ": keep-alive\n\n")
+		case e, ok := <-ch:
+			if !ok {
+				return
+			}
+			v, ok := pick(e)
+			if !ok {
+				continue
+			}
+			err = writeEvent(w, name, v)
+		}
+		if err == nil {
+			err = rc.Flush()
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// writeEvent writes one SSE event with v as JSON data
+func writeEvent(w http.ResponseWriter, name string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, data)
+	return err
+}
+
+// filter returns the comma-separated values of a query parameter, normalized,
+// or nil if the parameter is absent
+func filter(r *http.Request, param string, normalize func(string) string) map[string]bool {
+	var set map[string]bool
+	for _, values := range r.URL.Query()[param] {
+		for _, v := range strings.Split(values, ",") {
+			if v = normalize(strings.TrimSpace(v)); v == "" {
+				continue
+			}
+			if set == nil {
+				set = make(map[string]bool)
+			}
+			set[v] = true
+		}
+	}
+	return set
+}
+
+// indexName normalizes an index name for the names filter, e.g. "Sensitive
+// Float" to "sensitive-float"
+func indexName(name string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "-")
+}